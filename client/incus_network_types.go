@@ -0,0 +1,43 @@
+package incus
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+// GetNetworkTypes returns the network types supported by the server along with their configuration keys.
+func (r *ProtocolIncus) GetNetworkTypes() ([]api.NetworkType, error) {
+	err := r.CheckExtension("network_types")
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch the raw value.
+	networkTypes := []api.NetworkType{}
+	_, err = r.queryStruct("GET", "/network-types", nil, "", &networkTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	return networkTypes, nil
+}
+
+// GetNetworkTypeDefaults returns the configuration filled in when creating a network of the given type.
+// Automatically selected values (such as subnets) are only indicative and aren't reserved.
+func (r *ProtocolIncus) GetNetworkTypeDefaults(networkType string) (map[string]string, error) {
+	err := r.CheckExtension("network_type_defaults")
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch the raw value.
+	config := map[string]string{}
+	_, err = r.queryStruct("GET", fmt.Sprintf("/network-types/%s/defaults", url.PathEscape(networkType)), nil, "", &config)
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
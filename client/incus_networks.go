@@ -3,7 +3,9 @@ package incus
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/lxc/incus/v6/shared/api"
 )
@@ -79,6 +81,56 @@ func (r *ProtocolIncus) GetNetworksAllProjects() ([]api.Network, error) {
 	return networks, nil
 }
 
+// GetNetworksUsingACL returns the networks referencing the given ACL in their security.acls.
+func (r *ProtocolIncus) GetNetworksUsingACL(aclName string) ([]api.Network, error) {
+	if !r.HasExtension("network_uses_acl_filter") {
+		return nil, errors.New(`The server is missing the required "network_uses_acl_filter" API extension`)
+	}
+
+	networks := []api.Network{}
+
+	v := url.Values{}
+	v.Set("recursion", "1")
+	v.Set("uses-acl", aclName)
+
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks?%s", v.Encode()), nil, "", &networks)
+	if err != nil {
+		return nil, err
+	}
+
+	return networks, nil
+}
+
+// GetNetworksByUplink gets the list of networks with the OVN networks grouped under their uplink.
+func (r *ProtocolIncus) GetNetworksByUplink() ([]api.NetworkUplinkGroup, error) {
+	if !r.HasExtension("network_group_by_uplink") {
+		return nil, errors.New(`The server is missing the required "network_group_by_uplink" API extension`)
+	}
+
+	groups := []api.NetworkUplinkGroup{}
+	_, err := r.queryStruct("GET", "/networks?recursion=1&group-by=uplink", nil, "", &groups)
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// GetNetworksAllProjectsByUplink gets the networks across all projects with the OVN networks grouped under their uplink.
+func (r *ProtocolIncus) GetNetworksAllProjectsByUplink() ([]api.NetworkUplinkGroup, error) {
+	if !r.HasExtension("network_group_by_uplink") {
+		return nil, errors.New(`The server is missing the required "network_group_by_uplink" API extension`)
+	}
+
+	groups := []api.NetworkUplinkGroup{}
+	_, err := r.queryStruct("GET", "/networks?recursion=1&all-projects=true&group-by=uplink", nil, "", &groups)
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
 // GetNetworksAllProjectsWithFilter gets a filtered list of all networks across all projects.
 func (r *ProtocolIncus) GetNetworksAllProjectsWithFilter(filters []string) ([]api.Network, error) {
 	if !r.HasExtension("networks_all_projects") {
@@ -100,6 +152,73 @@ func (r *ProtocolIncus) GetNetworksAllProjectsWithFilter(filters []string) ([]ap
 	return networks, nil
 }
 
+// GetNetworksInProjects gets all networks across the given projects.
+func (r *ProtocolIncus) GetNetworksInProjects(projectNames []string) ([]api.Network, error) {
+	if !r.HasExtension("networks_all_projects_filter") {
+		return nil, errors.New(`The server is missing the required "networks_all_projects_filter" API extension`)
+	}
+
+	networks := []api.Network{}
+
+	v := url.Values{}
+	v.Set("recursion", "1")
+	v.Set("all-projects", "true")
+	for _, projectName := range projectNames {
+		v.Add("project", projectName)
+	}
+
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks?%s", v.Encode()), nil, "", &networks)
+	if err != nil {
+		return nil, err
+	}
+
+	return networks, nil
+}
+
+// GetNetworkByUUID returns the network with the given UUID, regardless of its project.
+func (r *ProtocolIncus) GetNetworkByUUID(networkUUID string) (*api.Network, error) {
+	if !r.HasExtension("network_uuid") {
+		return nil, errors.New(`The server is missing the required "network_uuid" API extension`)
+	}
+
+	networks := []api.Network{}
+
+	v := url.Values{}
+	v.Set("recursion", "1")
+	v.Set("uuid", networkUUID)
+
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks?%s", v.Encode()), nil, "", &networks)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(networks) != 1 {
+		return nil, api.StatusErrorf(http.StatusNotFound, "Network not found")
+	}
+
+	return &networks[0], nil
+}
+
+// CheckNetworkSubnet returns whether the subnet overlaps the addressing of existing networks.
+func (r *ProtocolIncus) CheckNetworkSubnet(subnet string) (*api.NetworkSubnetCheck, error) {
+	if !r.HasExtension("network_check_subnet") {
+		return nil, errors.New("The server is missing the required \"network_check_subnet\" API extension")
+	}
+
+	result := api.NetworkSubnetCheck{}
+
+	v := url.Values{}
+	v.Set("check-subnet", subnet)
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks?%s", v.Encode()), nil, "", &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 // GetNetwork returns a Network entry for the provided name.
 func (r *ProtocolIncus) GetNetwork(name string) (*api.Network, string, error) {
 	if !r.HasExtension("network") {
@@ -117,6 +236,40 @@ func (r *ProtocolIncus) GetNetwork(name string) (*api.Network, string, error) {
 	return &network, etag, nil
 }
 
+// GetNetworkRendered returns a Network entry including the driver artifacts rendered from its current config.
+func (r *ProtocolIncus) GetNetworkRendered(name string) (*api.Network, error) {
+	if !r.HasExtension("network_render") {
+		return nil, errors.New("The server is missing the required \"network_render\" API extension")
+	}
+
+	network := api.Network{}
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s?render=true", url.PathEscape(name)), nil, "", &network)
+	if err != nil {
+		return nil, err
+	}
+
+	return &network, nil
+}
+
+// GetNetworkExport returns a portable definition of the network along with its ACLs, forwards and zones.
+func (r *ProtocolIncus) GetNetworkExport(name string) (*api.NetworkExport, error) {
+	if !r.HasExtension("network_export") {
+		return nil, errors.New("The server is missing the required \"network_export\" API extension")
+	}
+
+	export := api.NetworkExport{}
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s?export=true", url.PathEscape(name)), nil, "", &export)
+	if err != nil {
+		return nil, err
+	}
+
+	return &export, nil
+}
+
 // GetNetworkLeases returns a list of Network struct.
 func (r *ProtocolIncus) GetNetworkLeases(name string) ([]api.NetworkLease, error) {
 	if !r.HasExtension("network_leases") {
@@ -134,6 +287,107 @@ func (r *ProtocolIncus) GetNetworkLeases(name string) ([]api.NetworkLease, error
 	return leases, nil
 }
 
+// GetNetworkLeasesWithFilter returns a filtered list of DHCP leases of the network.
+func (r *ProtocolIncus) GetNetworkLeasesWithFilter(name string, filters []string) ([]api.NetworkLease, error) {
+	if !r.HasExtension("network_leases_filter") {
+		return nil, errors.New("The server is missing the required \"network_leases_filter\" API extension")
+	}
+
+	leases := []api.NetworkLease{}
+
+	v := url.Values{}
+	v.Set("filter", parseFilters(filters))
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/leases?%s", url.PathEscape(name), v.Encode()), nil, "", &leases)
+	if err != nil {
+		return nil, err
+	}
+
+	return leases, nil
+}
+
+// ImportNetworkLeases imports the leases of an existing dnsmasq leases file into the network.
+func (r *ProtocolIncus) ImportNetworkLeases(name string, leases api.NetworkLeasesImport) (*api.NetworkLeasesImportResult, error) {
+	if !r.HasExtension("network_leases_import") {
+		return nil, errors.New("The server is missing the required \"network_leases_import\" API extension")
+	}
+
+	result := api.NetworkLeasesImportResult{}
+
+	// Send the request
+	_, err := r.queryStruct("POST", fmt.Sprintf("/networks/%s/leases", url.PathEscape(name)), leases, "", &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreateNetworkLeaseReservation adds a static DHCP reservation to the network.
+func (r *ProtocolIncus) CreateNetworkLeaseReservation(name string, reservation api.NetworkLeaseReservation) error {
+	if !r.HasExtension("network_lease_reservations") {
+		return errors.New("The server is missing the required \"network_lease_reservations\" API extension")
+	}
+
+	// Send the request
+	_, _, err := r.query("POST", fmt.Sprintf("/networks/%s/reservations", url.PathEscape(name)), reservation, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteNetworkLease removes the dynamic DHCP lease of the address.
+func (r *ProtocolIncus) DeleteNetworkLease(name string, address string) error {
+	if !r.HasExtension("network_lease_delete") {
+		return errors.New("The server is missing the required \"network_lease_delete\" API extension")
+	}
+
+	// Send the request
+	_, _, err := r.query("DELETE", fmt.Sprintf("/networks/%s/leases/%s", url.PathEscape(name), url.PathEscape(address)), nil, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetNetworkPortBindings returns the MAC and IP bindings of the network's instance ports.
+func (r *ProtocolIncus) GetNetworkPortBindings(name string) ([]api.NetworkPortBinding, error) {
+	if !r.HasExtension("network_port_bindings") {
+		return nil, errors.New("The server is missing the required \"network_port_bindings\" API extension")
+	}
+
+	bindings := []api.NetworkPortBinding{}
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/port-bindings", url.PathEscape(name)), nil, "", &bindings)
+	if err != nil {
+		return nil, err
+	}
+
+	return bindings, nil
+}
+
+// GetNetworkInstances returns the instance devices connected to the network along with their addresses.
+func (r *ProtocolIncus) GetNetworkInstances(name string) ([]api.NetworkInstance, error) {
+	if !r.HasExtension("network_instances") {
+		return nil, errors.New("The server is missing the required \"network_instances\" API extension")
+	}
+
+	instances := []api.NetworkInstance{}
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/instances", url.PathEscape(name)), nil, "", &instances)
+	if err != nil {
+		return nil, err
+	}
+
+	return instances, nil
+}
+
 // GetNetworkState returns metrics and information on the running network.
 func (r *ProtocolIncus) GetNetworkState(name string) (*api.NetworkState, error) {
 	if !r.HasExtension("network_state") {
@@ -151,12 +405,170 @@ func (r *ProtocolIncus) GetNetworkState(name string) (*api.NetworkState, error)
 	return &state, nil
 }
 
+// GetNetworkStats returns the traffic statistics of the network and of the instances connected to it.
+func (r *ProtocolIncus) GetNetworkStats(name string) (*api.NetworkStats, error) {
+	if !r.HasExtension("network_stats") {
+		return nil, errors.New("The server is missing the required \"network_stats\" API extension")
+	}
+
+	stats := api.NetworkStats{}
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/stats", url.PathEscape(name)), nil, "", &stats)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+// GetNetworkStateAllMembers returns metrics and information on the running network from every cluster member,
+// keyed by member name.
+func (r *ProtocolIncus) GetNetworkStateAllMembers(name string) (map[string]api.NetworkState, error) {
+	if !r.HasExtension("network_state_all_members") {
+		return nil, errors.New("The server is missing the required \"network_state_all_members\" API extension")
+	}
+
+	states := map[string]api.NetworkState{}
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/state?all-members=true", url.PathEscape(name)), nil, "", &states)
+	if err != nil {
+		return nil, err
+	}
+
+	return states, nil
+}
+
+// GetNetworkStateQueues returns the running network state including per-queue information.
+func (r *ProtocolIncus) GetNetworkStateQueues(name string) (*api.NetworkState, error) {
+	if !r.HasExtension("network_state_queues") {
+		return nil, errors.New("The server is missing the required \"network_state_queues\" API extension")
+	}
+
+	state := api.NetworkState{}
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/state?detail=queues", url.PathEscape(name)), nil, "", &state)
+	if err != nil {
+		return nil, err
+	}
+
+	return &state, nil
+}
+
+// GetNetworkStatePorts returns the running network state including the instance ports.
+func (r *ProtocolIncus) GetNetworkStatePorts(name string) (*api.NetworkState, error) {
+	if !r.HasExtension("network_state_ports") {
+		return nil, errors.New("The server is missing the required \"network_state_ports\" API extension")
+	}
+
+	state := api.NetworkState{}
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/state?detail=ports", url.PathEscape(name)), nil, "", &state)
+	if err != nil {
+		return nil, err
+	}
+
+	return &state, nil
+}
+
+// GetNetworkDHCPUtilization returns the fraction of the network's DHCPv4 pool that is currently allocated.
+func (r *ProtocolIncus) GetNetworkDHCPUtilization(name string) (float64, error) {
+	if !r.HasExtension("network_state_dhcp_utilization") {
+		return 0, errors.New("The server is missing the required \"network_state_dhcp_utilization\" API extension")
+	}
+
+	var utilization float64
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/state?field=dhcp.utilization", url.PathEscape(name)), nil, "", &utilization)
+	if err != nil {
+		return 0, err
+	}
+
+	return utilization, nil
+}
+
+// GetNetworkConnectivity tests the reachability of the network's gateways from the server.
+func (r *ProtocolIncus) GetNetworkConnectivity(name string) (*api.NetworkConnectivity, error) {
+	if !r.HasExtension("network_connectivity") {
+		return nil, errors.New("The server is missing the required \"network_connectivity\" API extension")
+	}
+
+	result := api.NetworkConnectivity{}
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/connectivity", url.PathEscape(name)), nil, "", &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetNetworkConnectivityAllMembers tests the reachability of the network's gateways from every cluster member.
+func (r *ProtocolIncus) GetNetworkConnectivityAllMembers(name string) ([]api.NetworkConnectivity, error) {
+	if !r.HasExtension("network_connectivity") {
+		return nil, errors.New("The server is missing the required \"network_connectivity\" API extension")
+	}
+
+	results := []api.NetworkConnectivity{}
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/connectivity?all-members=true", url.PathEscape(name)), nil, "", &results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// GetNetworkConsistency checks that the network's database record, authorizer entry and dataplane state agree.
+func (r *ProtocolIncus) GetNetworkConsistency(name string) (*api.NetworkConsistency, error) {
+	if !r.HasExtension("network_consistency") {
+		return nil, errors.New("The server is missing the required \"network_consistency\" API extension")
+	}
+
+	result := api.NetworkConsistency{}
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/consistency", url.PathEscape(name)), nil, "", &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetNetworkEvents returns the recent lifecycle events of a network.
+func (r *ProtocolIncus) GetNetworkEvents(name string) ([]api.Event, error) {
+	if !r.HasExtension("network_events") {
+		return nil, errors.New("The server is missing the required \"network_events\" API extension")
+	}
+
+	events := []api.Event{}
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/events", url.PathEscape(name)), nil, "", &events)
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
 // CreateNetwork defines a new network using the provided Network struct.
 func (r *ProtocolIncus) CreateNetwork(network api.NetworksPost) error {
 	if !r.HasExtension("network") {
 		return errors.New("The server is missing the required \"network\" API extension")
 	}
 
+	if network.Source != "" && !r.HasExtension("network_copy") {
+		return errors.New("The server is missing the required \"network_copy\" API extension")
+	}
+
 	// Send the request
 	_, _, err := r.query("POST", "/networks", network, "")
 	if err != nil {
@@ -166,6 +578,109 @@ func (r *ProtocolIncus) CreateNetwork(network api.NetworksPost) error {
 	return nil
 }
 
+// ImportNetwork recreates a network along with its forwards and zones from an exported definition.
+// If overwrite is set, an existing network is updated to match the definition instead of failing.
+func (r *ProtocolIncus) ImportNetwork(export api.NetworkExport, overwrite bool) error {
+	if !r.HasExtension("network_import") {
+		return errors.New("The server is missing the required \"network_import\" API extension")
+	}
+
+	path := "/networks?import=1"
+	if overwrite {
+		path += "&overwrite=1"
+	}
+
+	// Send the request
+	_, _, err := r.query("POST", path, export, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateNetworkAsync creates the network across the cluster as a background operation, reporting progress as
+// each cluster member completes.
+func (r *ProtocolIncus) CreateNetworkAsync(network api.NetworksPost) (Operation, error) {
+	if !r.HasExtension("network_create_async") {
+		return nil, errors.New("The server is missing the required \"network_create_async\" API extension")
+	}
+
+	// Send the request
+	op, _, err := r.queryOperation("POST", "/networks?async=1", network, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+// ApplyNetwork creates the network or converges the existing one to the provided state.
+func (r *ProtocolIncus) ApplyNetwork(network api.NetworksPost) (*api.NetworkApplyResult, error) {
+	if !r.HasExtension("network_apply") {
+		return nil, errors.New("The server is missing the required \"network_apply\" API extension")
+	}
+
+	result := api.NetworkApplyResult{}
+
+	// Send the request
+	_, err := r.queryStruct("POST", "/networks?apply=true", network, "", &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ValidateNetwork checks whether the network would be accepted by the server, without creating it.
+func (r *ProtocolIncus) ValidateNetwork(network api.NetworksPost) error {
+	if !r.HasExtension("network_create_validate") {
+		return errors.New("The server is missing the required \"network_create_validate\" API extension")
+	}
+
+	// Send the request
+	_, _, err := r.query("POST", "/networks?validate=true", network, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateNetworkConfig checks the config of a network to be created, reporting the failures of each config key.
+func (r *ProtocolIncus) ValidateNetworkConfig(network api.NetworksPost) (*api.NetworkConfigValidation, error) {
+	if !r.HasExtension("network_validate_keys") {
+		return nil, errors.New("The server is missing the required \"network_validate_keys\" API extension")
+	}
+
+	result := api.NetworkConfigValidation{}
+
+	// Send the request
+	_, err := r.queryStruct("POST", "/networks?validate=keys", network, "", &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ValidateNetworkUpdate checks the proposed config of an existing network, reporting the failures of each config key.
+func (r *ProtocolIncus) ValidateNetworkUpdate(name string, network api.NetworkPut) (*api.NetworkConfigValidation, error) {
+	if !r.HasExtension("network_validate_keys") {
+		return nil, errors.New("The server is missing the required \"network_validate_keys\" API extension")
+	}
+
+	result := api.NetworkConfigValidation{}
+
+	// Send the request
+	_, err := r.queryStruct("POST", fmt.Sprintf("/networks/%s/validate", url.PathEscape(name)), network, "", &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 // UpdateNetwork updates the network to match the provided Network struct.
 func (r *ProtocolIncus) UpdateNetwork(name string, network api.NetworkPut, ETag string) error {
 	if !r.HasExtension("network") {
@@ -181,6 +696,71 @@ func (r *ProtocolIncus) UpdateNetwork(name string, network api.NetworkPut, ETag
 	return nil
 }
 
+// PreviewNetworkUpdate returns the config the network would have after the update and the keys it changes,
+// without applying it.
+func (r *ProtocolIncus) PreviewNetworkUpdate(name string, network api.NetworkPut, ETag string) (*api.NetworkUpdatePreview, error) {
+	if !r.HasExtension("network_update_preview") {
+		return nil, errors.New("The server is missing the required \"network_update_preview\" API extension")
+	}
+
+	preview := api.NetworkUpdatePreview{}
+
+	// Send the request
+	_, err := r.queryStruct("PUT", fmt.Sprintf("/networks/%s?preview=1", url.PathEscape(name)), network, ETag, &preview)
+	if err != nil {
+		return nil, err
+	}
+
+	return &preview, nil
+}
+
+// ScheduleNetworkUpdate schedules an update of the network to be applied at the given time.
+func (r *ProtocolIncus) ScheduleNetworkUpdate(name string, network api.NetworkPut, ETag string, applyAt time.Time) error {
+	if !r.HasExtension("network_scheduled_changes") {
+		return errors.New("The server is missing the required \"network_scheduled_changes\" API extension")
+	}
+
+	// Send the request
+	_, _, err := r.query("PUT", fmt.Sprintf("/networks/%s?apply-at=%s", url.PathEscape(name), url.QueryEscape(applyAt.Format(time.RFC3339))), network, ETag)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetNetworkScheduledChange returns the change scheduled to be applied to the network.
+func (r *ProtocolIncus) GetNetworkScheduledChange(name string) (*api.NetworkScheduledChange, error) {
+	if !r.HasExtension("network_scheduled_changes") {
+		return nil, errors.New("The server is missing the required \"network_scheduled_changes\" API extension")
+	}
+
+	change := api.NetworkScheduledChange{}
+
+	// Fetch the raw value
+	_, err := r.queryStruct("GET", fmt.Sprintf("/networks/%s/scheduled-change", url.PathEscape(name)), nil, "", &change)
+	if err != nil {
+		return nil, err
+	}
+
+	return &change, nil
+}
+
+// DeleteNetworkScheduledChange cancels the change scheduled to be applied to the network.
+func (r *ProtocolIncus) DeleteNetworkScheduledChange(name string) error {
+	if !r.HasExtension("network_scheduled_changes") {
+		return errors.New("The server is missing the required \"network_scheduled_changes\" API extension")
+	}
+
+	// Send the request
+	_, _, err := r.query("DELETE", fmt.Sprintf("/networks/%s/scheduled-change", url.PathEscape(name)), nil, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // RenameNetwork renames an existing network entry.
 func (r *ProtocolIncus) RenameNetwork(name string, network api.NetworkPost) error {
 	if !r.HasExtension("network") {
@@ -210,3 +790,122 @@ func (r *ProtocolIncus) DeleteNetwork(name string) error {
 
 	return nil
 }
+
+// DeleteNetworkForce deletes a network which failed to be created, even if cleaning it up fails.
+func (r *ProtocolIncus) DeleteNetworkForce(name string) error {
+	if !r.HasExtension("network_delete_force") {
+		return errors.New("The server is missing the required \"network_delete_force\" API extension")
+	}
+
+	// Send the request
+	_, _, err := r.query("DELETE", fmt.Sprintf("/networks/%s?force=1", url.PathEscape(name)), nil, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CheckNetworkDelete returns whether the network can be deleted along with the resources preventing it.
+func (r *ProtocolIncus) CheckNetworkDelete(name string) (*api.NetworkDeleteCheck, error) {
+	if !r.HasExtension("network_delete_check") {
+		return nil, errors.New("The server is missing the required \"network_delete_check\" API extension")
+	}
+
+	check := api.NetworkDeleteCheck{}
+
+	// Send the request
+	_, err := r.queryStruct("DELETE", fmt.Sprintf("/networks/%s?check=1", url.PathEscape(name)), nil, "", &check)
+	if err != nil {
+		return nil, err
+	}
+
+	return &check, nil
+}
+
+// DeleteNetworksWithFilter deletes all the networks matching the filters, returning the outcome for each of them.
+func (r *ProtocolIncus) DeleteNetworksWithFilter(filters []string) ([]api.NetworksDeleteResult, error) {
+	if !r.HasExtension("network_bulk_delete") {
+		return nil, errors.New("The server is missing the required \"network_bulk_delete\" API extension")
+	}
+
+	results := []api.NetworksDeleteResult{}
+
+	v := url.Values{}
+	v.Set("filter", parseFilters(filters))
+
+	// Send the request
+	_, err := r.queryStruct("DELETE", fmt.Sprintf("/networks?%s", v.Encode()), nil, "", &results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// DeleteNetworks deletes the named networks, provided that all of them can be deleted, returning the outcome for each of them.
+func (r *ProtocolIncus) DeleteNetworks(names []string) ([]api.NetworksDeleteResult, error) {
+	if !r.HasExtension("network_bulk_delete_names") {
+		return nil, errors.New("The server is missing the required \"network_bulk_delete_names\" API extension")
+	}
+
+	results := []api.NetworksDeleteResult{}
+
+	v := url.Values{}
+	for _, name := range names {
+		v.Add("name", name)
+	}
+
+	// Send the request
+	_, err := r.queryStruct("DELETE", fmt.Sprintf("/networks?%s", v.Encode()), nil, "", &results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// RegenerateNetwork has the server re-apply the network's runtime configuration from its database record.
+func (r *ProtocolIncus) RegenerateNetwork(name string) error {
+	if !r.HasExtension("network_regenerate") {
+		return errors.New("The server is missing the required \"network_regenerate\" API extension")
+	}
+
+	// Send the request
+	_, _, err := r.query("POST", fmt.Sprintf("/networks/%s/regenerate", url.PathEscape(name)), nil, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RestartNetwork stops and starts the network on the server, re-applying its local configuration.
+func (r *ProtocolIncus) RestartNetwork(name string) (Operation, error) {
+	if !r.HasExtension("network_restart_operation") {
+		return nil, errors.New("The server is missing the required \"network_restart_operation\" API extension")
+	}
+
+	// Send the request
+	op, _, err := r.queryOperation("POST", fmt.Sprintf("/networks/%s/restart", url.PathEscape(name)), nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+// RecreateNetwork tears down the network's driver state and sets it up again from its stored config.
+func (r *ProtocolIncus) RecreateNetwork(name string) error {
+	if !r.HasExtension("network_recreate") {
+		return errors.New("The server is missing the required \"network_recreate\" API extension")
+	}
+
+	// Send the request
+	_, _, err := r.query("POST", fmt.Sprintf("/networks/%s/recreate", url.PathEscape(name)), nil, "")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/pkg/sftp"
@@ -85,6 +86,7 @@ type InstanceServer interface {
 	IsClustered() (clustered bool)
 	UseTarget(name string) (client InstanceServer)
 	UseProject(name string) (client InstanceServer)
+	WithContext(ctx context.Context) (client InstanceServer)
 
 	// Certificate functions
 	GetCertificateFingerprints() (fingerprints []string, err error)
@@ -198,13 +200,53 @@ type InstanceServer interface {
 	GetNetworksWithFilter(filters []string) (networks []api.Network, err error)
 	GetNetworksAllProjects() (networks []api.Network, err error)
 	GetNetworksAllProjectsWithFilter(filters []string) (networks []api.Network, err error)
+	GetNetworksInProjects(projectNames []string) (networks []api.Network, err error)
+	GetNetworkByUUID(networkUUID string) (network *api.Network, err error)
+	GetNetworksUsingACL(aclName string) (networks []api.Network, err error)
+	GetNetworksByUplink() (groups []api.NetworkUplinkGroup, err error)
+	GetNetworksAllProjectsByUplink() (groups []api.NetworkUplinkGroup, err error)
+	CheckNetworkSubnet(subnet string) (result *api.NetworkSubnetCheck, err error)
 	GetNetwork(name string) (network *api.Network, ETag string, err error)
+	GetNetworkRendered(name string) (network *api.Network, err error)
+	GetNetworkExport(name string) (export *api.NetworkExport, err error)
 	GetNetworkLeases(name string) (leases []api.NetworkLease, err error)
+	GetNetworkLeasesWithFilter(name string, filters []string) (leases []api.NetworkLease, err error)
+	ImportNetworkLeases(name string, leases api.NetworkLeasesImport) (result *api.NetworkLeasesImportResult, err error)
+	CreateNetworkLeaseReservation(name string, reservation api.NetworkLeaseReservation) (err error)
+	DeleteNetworkLease(name string, address string) (err error)
+	GetNetworkPortBindings(name string) (bindings []api.NetworkPortBinding, err error)
+	GetNetworkInstances(name string) (instances []api.NetworkInstance, err error)
 	GetNetworkState(name string) (state *api.NetworkState, err error)
+	GetNetworkStateAllMembers(name string) (states map[string]api.NetworkState, err error)
+	GetNetworkStateQueues(name string) (state *api.NetworkState, err error)
+	GetNetworkStats(name string) (stats *api.NetworkStats, err error)
+	GetNetworkStatePorts(name string) (state *api.NetworkState, err error)
+	GetNetworkDHCPUtilization(name string) (utilization float64, err error)
+	GetNetworkConnectivity(name string) (result *api.NetworkConnectivity, err error)
+	GetNetworkConnectivityAllMembers(name string) (results []api.NetworkConnectivity, err error)
+	GetNetworkConsistency(name string) (result *api.NetworkConsistency, err error)
+	GetNetworkEvents(name string) (events []api.Event, err error)
 	CreateNetwork(network api.NetworksPost) (err error)
+	CreateNetworkAsync(network api.NetworksPost) (op Operation, err error)
+	ImportNetwork(export api.NetworkExport, overwrite bool) (err error)
+	ApplyNetwork(network api.NetworksPost) (result *api.NetworkApplyResult, err error)
+	ValidateNetwork(network api.NetworksPost) (err error)
+	ValidateNetworkConfig(network api.NetworksPost) (result *api.NetworkConfigValidation, err error)
+	ValidateNetworkUpdate(name string, network api.NetworkPut) (result *api.NetworkConfigValidation, err error)
 	UpdateNetwork(name string, network api.NetworkPut, ETag string) (err error)
+	PreviewNetworkUpdate(name string, network api.NetworkPut, ETag string) (preview *api.NetworkUpdatePreview, err error)
+	ScheduleNetworkUpdate(name string, network api.NetworkPut, ETag string, applyAt time.Time) (err error)
+	GetNetworkScheduledChange(name string) (change *api.NetworkScheduledChange, err error)
+	DeleteNetworkScheduledChange(name string) (err error)
 	RenameNetwork(name string, network api.NetworkPost) (err error)
 	DeleteNetwork(name string) (err error)
+	DeleteNetworkForce(name string) (err error)
+	CheckNetworkDelete(name string) (check *api.NetworkDeleteCheck, err error)
+	DeleteNetworksWithFilter(filters []string) (results []api.NetworksDeleteResult, err error)
+	DeleteNetworks(names []string) (results []api.NetworksDeleteResult, err error)
+	RegenerateNetwork(name string) (err error)
+	RecreateNetwork(name string) (err error)
+	RestartNetwork(name string) (op Operation, err error)
 
 	// Network forward functions ("network_forward" API extension)
 	GetNetworkForwardAddresses(networkName string) ([]string, error)
@@ -256,6 +298,10 @@ type InstanceServer interface {
 	GetNetworkAllocations() (allocations []api.NetworkAllocations, err error)
 	GetNetworkAllocationsAllProjects() (allocations []api.NetworkAllocations, err error)
 
+	// Network types functions ("network_types" API extension)
+	GetNetworkTypes() (networkTypes []api.NetworkType, err error)
+	GetNetworkTypeDefaults(networkType string) (config map[string]string, err error)
+
 	// Network zone functions ("network_dns" API extension)
 	GetNetworkZonesAllProjects() (zones []api.NetworkZone, err error)
 	GetNetworkZoneNames() (names []string, err error)
@@ -82,9 +82,22 @@ var api10 = []APIEndpoint{
 	imageSecretCmd,
 	metadataConfigurationCmd,
 	networkCmd,
+	networkConnectivityCmd,
+	networkConsistencyCmd,
+	networkEventsCmd,
+	networkInstancesCmd,
+	networkLeaseCmd,
 	networkLeasesCmd,
+	networkReservationsCmd,
+	networkPortBindingsCmd,
+	networkRecreateCmd,
+	networkRegenerateCmd,
+	networkRestartCmd,
+	networkScheduledChangeCmd,
 	networksCmd,
 	networkStateCmd,
+	networkStatsCmd,
+	networkValidateCmd,
 	networkACLCmd,
 	networkACLsCmd,
 	networkACLLogCmd,
@@ -100,6 +113,8 @@ var api10 = []APIEndpoint{
 	networkLoadBalancersCmd,
 	networkPeerCmd,
 	networkPeersCmd,
+	networkTypeDefaultsCmd,
+	networkTypesCmd,
 	networkZoneCmd,
 	networkZonesCmd,
 	networkZoneRecordCmd,
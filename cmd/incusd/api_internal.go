@@ -62,6 +62,7 @@ var apiInternal = []APIEndpoint{
 	internalGarbageCollectorCmd,
 	internalImageOptimizeCmd,
 	internalImageRefreshCmd,
+	internalNetworkAuthorizerSyncCmd,
 	internalRAFTSnapshotCmd,
 	internalRebalanceLoadCmd,
 	internalReadyCmd,
@@ -90,6 +91,12 @@ var internalImageOptimizeCmd = APIEndpoint{
 	Post: APIEndpointAction{Handler: internalOptimizeImage, AccessHandler: allowPermission(auth.ObjectTypeServer, auth.EntitlementCanEdit)},
 }
 
+var internalNetworkAuthorizerSyncCmd = APIEndpoint{
+	Path: "networks/authorizer-sync",
+
+	Post: APIEndpointAction{Handler: internalNetworkAuthorizerSync, AccessHandler: allowPermission(auth.ObjectTypeServer, auth.EntitlementCanEdit)},
+}
+
 var internalRebalanceLoadCmd = APIEndpoint{
 	Path: "rebalance",
 
@@ -196,6 +203,12 @@ type internalImageOptimizePost struct {
 	Pool  string    `json:"pool"  yaml:"pool"`
 }
 
+type internalNetworkAuthorizerSyncResult struct {
+	Added   []string          `json:"added"   yaml:"added"`
+	Present []string          `json:"present" yaml:"present"`
+	Failed  map[string]string `json:"failed"  yaml:"failed"`
+}
+
 type internalWarningCreatePost struct {
 	Location       string `json:"location"         yaml:"location"`
 	Project        string `json:"project"          yaml:"project"`
@@ -1101,6 +1114,60 @@ func internalBGPState(d *Daemon, _ *http.Request) response.Response {
 	return response.SyncResponse(true, s.BGP.Debug())
 }
 
+// internalNetworkAuthorizerSync re-adds any managed network missing from the authorizer.
+// Networks are reported as "<project>/<network>".
+func internalNetworkAuthorizerSync(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	var projectNetworks map[string]map[int64]api.Network
+	err := s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+		projectNetworks, err = tx.GetCreatedNetworks(ctx)
+
+		return err
+	})
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	result := internalNetworkAuthorizerSyncResult{
+		Added:   []string{},
+		Present: []string{},
+		Failed:  map[string]string{},
+	}
+
+	for projectName, networks := range projectNetworks {
+		for _, netInfo := range networks {
+			entry := projectName + "/" + netInfo.Name
+
+			found, err := s.Authorizer.HasNetwork(r.Context(), projectName, netInfo.Name)
+			if err != nil {
+				result.Failed[entry] = err.Error()
+				continue
+			}
+
+			if found {
+				result.Present = append(result.Present, entry)
+				continue
+			}
+
+			err = s.Authorizer.AddNetwork(r.Context(), projectName, netInfo.Name)
+			if err != nil {
+				result.Failed[entry] = err.Error()
+				continue
+			}
+
+			logger.Info("Re-added network to the authorizer", logger.Ctx{"project": projectName, "network": netInfo.Name})
+			result.Added = append(result.Added, entry)
+		}
+	}
+
+	slices.Sort(result.Added)
+	slices.Sort(result.Present)
+
+	return response.SyncResponse(true, result)
+}
+
 func internalRebalanceLoad(d *Daemon, _ *http.Request) response.Response {
 	err := autoRebalanceCluster(context.TODO(), d)
 	if err != nil {
@@ -374,6 +374,11 @@ func projectsPost(d *Daemon, r *http.Request) response.Response {
 		return response.BadRequest(err)
 	}
 
+	err = projectValidateDefaultNetwork(r.Context(), s, project.Name, project.Config)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
 	var id int64
 	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
 		id, err = cluster.CreateProject(ctx, tx.Tx(), cluster.Project{Description: project.Description, Name: project.Name})
@@ -765,6 +770,19 @@ func projectChange(ctx context.Context, s *state.State, project *api.Project, re
 		return response.BadRequest(err)
 	}
 
+	// Only check the default network when the change can affect it, so a network deleted since doesn't
+	// prevent unrelated changes.
+	for _, key := range configChanged {
+		if key == "network.default" || key == "features.networks" || strings.HasPrefix(key, "restricted") {
+			err = projectValidateDefaultNetwork(ctx, s, project.Name, req.Config)
+			if err != nil {
+				return response.SmartError(err)
+			}
+
+			break
+		}
+	}
+
 	// Update the database entry.
 	err = s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
 		err := projecthelpers.AllowProjectUpdate(tx, project.Name, req.Config, configChanged)
@@ -1346,6 +1364,36 @@ func isEitherAllowOrBlockOrManaged(value string) error {
 	return validate.Optional(validate.IsOneOf("block", "allow", "managed"))(value)
 }
 
+// projectValidateDefaultNetwork checks that the default network set in the project config exists and is allowed
+// by the project restrictions.
+func projectValidateDefaultNetwork(ctx context.Context, s *state.State, projectName string, config map[string]string) error {
+	networkName := config["network.default"]
+	if networkName == "" {
+		return nil
+	}
+
+	networkProjectName := projecthelpers.NetworkProjectFromRecord(&api.Project{Name: projectName, ProjectPut: api.ProjectPut{Config: config}})
+
+	err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		_, err := tx.GetNetworkID(ctx, networkProjectName, networkName)
+
+		return err
+	})
+	if err != nil {
+		if response.IsNotFoundError(err) {
+			return api.StatusErrorf(http.StatusBadRequest, "Default network %q doesn't exist in project %q", networkName, networkProjectName)
+		}
+
+		return err
+	}
+
+	if !projecthelpers.NetworkAllowed(config, networkName, true) {
+		return api.StatusErrorf(http.StatusBadRequest, "Default network %q isn't allowed by the project restrictions", networkName)
+	}
+
+	return nil
+}
+
 func projectValidateConfig(s *state.State, config map[string]string) error {
 	// Validate the project configuration.
 	projectConfigKeys := map[string]func(value string) error{
@@ -1503,6 +1551,31 @@ func projectValidateConfig(s *state.State, config map[string]string) error {
 		//  shortdesc: Maximum number of networks that the project can have
 		"limits.networks": validate.Optional(validate.IsUint32),
 
+		// gendoc:generate(entity=project, group=specific, key=network.default)
+		// Name of the network that instance creation tooling should use when none is specified.
+		// The network must exist in the project and be allowed by its restrictions.
+		// Networks report whether they're the project's default through their `project_default` field.
+		// ---
+		//  type: string
+		//  shortdesc: Default network of the project
+		"network.default": validate.Optional(validate.IsInterfaceName),
+
+		// gendoc:generate(entity=project, group=specific, key=network.default_type)
+		// Type of the networks created in the project when none is specified.
+		// When unset, OVN networks are created in projects with `features.networks` enabled and bridge networks otherwise.
+		// ---
+		//  type: string
+		//  shortdesc: Default type of the networks created in the project
+		"network.default_type": validate.Optional(validate.IsOneOf(network.Types()...)),
+
+		// gendoc:generate(entity=project, group=specific, key=network.names.case_insensitive)
+		// When enabled, creating a network whose name only differs by case from an existing network fails.
+		// ---
+		//  type: bool
+		//  defaultdesc: `false`
+		//  shortdesc: Whether network names must be unique regardless of case
+		"network.names.case_insensitive": validate.Optional(validate.IsBool),
+
 		// gendoc:generate(entity=project, group=restricted, key=restricted)
 		// This option must be enabled to allow the `restricted.*` keys to take effect.
 		// To temporarily remove the restrictions, you can disable this option instead of clearing the related keys.
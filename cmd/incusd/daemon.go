@@ -1443,6 +1443,14 @@ func (d *Daemon) init() error {
 		return err
 	}
 
+	// Record the recent lifecycle events of networks.
+	err = networkHistory.load(internalUtil.VarPath("network-history.json"))
+	if err != nil {
+		logger.Warn("Failed loading network history", logger.Ctx{"err": err})
+	}
+
+	d.internalListener.AddHandler("networkHistory", networkHistory.handleEvent)
+
 	// Setup syslog listener.
 	if syslogSocketEnabled {
 		err = d.setupSyslogSocket(true)
@@ -1689,6 +1697,15 @@ func (d *Daemon) init() error {
 
 		// Remove expired tokens (hourly)
 		d.tasks.Add(autoRemoveExpiredTokensTask(d))
+
+		// Apply scheduled network config changes (minutely)
+		d.tasks.Add(applyNetworkScheduledChangesTask(d))
+
+		// Check the network dataplanes for drift (minutely, acts per network.reconcile.interval)
+		d.tasks.Add(reconcileNetworksTask(d))
+
+		// Check the utilization of the network DHCP pools (every 5 minutes)
+		d.tasks.Add(checkNetworkDHCPPoolsTask(d))
 	}
 
 	// Start all background tasks
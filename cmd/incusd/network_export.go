@@ -0,0 +1,410 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/lxc/incus/v6/internal/server/auth"
+	"github.com/lxc/incus/v6/internal/server/cluster"
+	clusterRequest "github.com/lxc/incus/v6/internal/server/cluster/request"
+	"github.com/lxc/incus/v6/internal/server/db"
+	dbCluster "github.com/lxc/incus/v6/internal/server/db/cluster"
+	"github.com/lxc/incus/v6/internal/server/lifecycle"
+	"github.com/lxc/incus/v6/internal/server/network"
+	"github.com/lxc/incus/v6/internal/server/network/acl"
+	"github.com/lxc/incus/v6/internal/server/network/zone"
+	"github.com/lxc/incus/v6/internal/server/project"
+	"github.com/lxc/incus/v6/internal/server/request"
+	"github.com/lxc/incus/v6/internal/server/response"
+	"github.com/lxc/incus/v6/internal/server/state"
+	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/logger"
+	"github.com/lxc/incus/v6/shared/revert"
+	"github.com/lxc/incus/v6/shared/util"
+)
+
+// networkExportZoneKeys are the network config keys referencing network zones.
+var networkExportZoneKeys = []string{"dns.zone.forward", "dns.zone.reverse.ipv4", "dns.zone.reverse.ipv6"}
+
+// networkExport assembles a portable definition of the network from the info returned by doNetworkGet.
+// The ACLs and zones are taken from the visible config, so they are only included when the caller can see it.
+func networkExport(s *state.State, r *http.Request, projectName string, apiNet api.Network) (*api.NetworkExport, error) {
+	export := &api.NetworkExport{
+		Network: api.NetworksPost{
+			Name: apiNet.Name,
+			Type: apiNet.Type,
+			NetworkPut: api.NetworkPut{
+				Description: apiNet.Description,
+				Config:      db.StripNodeSpecificNetworkConfig(apiNet.Config),
+			},
+		},
+		ACLs:     []api.NetworkACL{},
+		Forwards: []api.NetworkForward{},
+		Zones:    []api.NetworkZone{},
+	}
+
+	for _, aclName := range util.SplitNTrimSpace(apiNet.Config["security.acls"], ",", -1, true) {
+		netACL, err := acl.LoadByName(s, projectName, aclName)
+		if err != nil {
+			return nil, fmt.Errorf("Failed loading network ACL %q: %w", aclName, err)
+		}
+
+		export.ACLs = append(export.ACLs, *netACL.Info())
+	}
+
+	for _, key := range networkExportZoneKeys {
+		for _, zoneName := range util.SplitNTrimSpace(apiNet.Config[key], ",", -1, true) {
+			netZone, err := zone.LoadByName(s, zoneName)
+			if err != nil {
+				return nil, fmt.Errorf("Failed loading network zone %q: %w", zoneName, err)
+			}
+
+			export.Zones = append(export.Zones, *netZone.Info())
+		}
+	}
+
+	n, err := network.LoadByName(s, projectName, apiNet.Name)
+	if err != nil {
+		return nil, fmt.Errorf("Failed loading network: %w", err)
+	}
+
+	if n.Info().AddressForwards {
+		export.Forwards, err = networkExportForwards(s, r, n)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return export, nil
+}
+
+// networkExportForwards returns the forwards of the network.
+func networkExportForwards(s *state.State, r *http.Request, n network.Network) ([]api.NetworkForward, error) {
+	forwards := []api.NetworkForward{}
+
+	err := s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		networkID := n.ID()
+		dbRecords, err := dbCluster.GetNetworkForwards(ctx, tx.Tx(), dbCluster.NetworkForwardFilter{
+			NetworkID: &networkID,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, dbRecord := range dbRecords {
+			forward, err := dbRecord.ToAPI(ctx, tx.Tx())
+			if err != nil {
+				return err
+			}
+
+			forwards = append(forwards, *forward)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed loading network forwards: %w", err)
+	}
+
+	return forwards, nil
+}
+
+// networksPostImport recreates a network along with its forwards and zones from a definition returned by
+// networkExport. The network itself goes through networksPost, either creating it or, with overwrite, converging
+// an existing network to the imported config.
+func networksPostImport(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	if util.IsTrue(request.QueryParam(r, "async")) || request.QueryParam(r, "target") != "" {
+		return response.BadRequest(errors.New("The import option can't be combined with the async or target options"))
+	}
+
+	projectName, _, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	zoneProjectName, _, err := project.NetworkZoneProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	req := api.NetworkExport{}
+
+	// Parse the request.
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	if req.Network.Name == "" {
+		return response.BadRequest(errors.New("No name provided"))
+	}
+
+	overwrite := util.IsTrue(request.QueryParam(r, "overwrite"))
+
+	existing, err := network.LoadByName(s, projectName, req.Network.Name)
+	if err != nil && !api.StatusErrorCheck(err, http.StatusNotFound) {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	if existing != nil && !overwrite {
+		return response.Conflict(fmt.Errorf("Network %q already exists", req.Network.Name))
+	}
+
+	// Check all dependencies up front so that a network is never left half configured.
+	var missing []string
+
+	for _, aclName := range util.SplitNTrimSpace(req.Network.Config["security.acls"], ",", -1, true) {
+		_, err := acl.LoadByName(s, projectName, aclName)
+		if err != nil {
+			if !api.StatusErrorCheck(err, http.StatusNotFound) {
+				return response.SmartError(fmt.Errorf("Failed loading network ACL %q: %w", aclName, err))
+			}
+
+			missing = append(missing, fmt.Sprintf("network ACL %q", aclName))
+		}
+	}
+
+	var newZones []api.NetworkZone
+	for _, key := range networkExportZoneKeys {
+		for _, zoneName := range util.SplitNTrimSpace(req.Network.Config[key], ",", -1, true) {
+			exists, err := networkImportZoneExists(r.Context(), s, zoneName)
+			if err != nil {
+				return response.SmartError(fmt.Errorf("Failed checking network zone %q: %w", zoneName, err))
+			}
+
+			if exists {
+				continue
+			}
+
+			idx := slices.IndexFunc(req.Zones, func(z api.NetworkZone) bool { return z.Name == zoneName })
+			if idx < 0 {
+				missing = append(missing, fmt.Sprintf("network zone %q", zoneName))
+				continue
+			}
+
+			newZones = append(newZones, req.Zones[idx])
+		}
+	}
+
+	if len(missing) > 0 {
+		return response.BadRequest(fmt.Errorf("Missing dependencies in project %q: %s", projectName, strings.Join(missing, ", ")))
+	}
+
+	if len(newZones) > 0 {
+		err = s.Authorizer.CheckPermission(r.Context(), r, auth.ObjectProject(zoneProjectName), auth.EntitlementCanCreateNetworkZones)
+		if err != nil {
+			return response.SmartError(err)
+		}
+	}
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	// Create the zones the network needs which don't exist yet.
+	for _, newZone := range newZones {
+		err = zone.Create(s, zoneProjectName, &api.NetworkZonesPost{Name: newZone.Name, NetworkZonePut: newZone.NetworkZonePut})
+		if err != nil {
+			return response.SmartError(fmt.Errorf("Failed creating network zone %q: %w", newZone.Name, err))
+		}
+
+		netZone, err := zone.LoadByNameAndProject(s, zoneProjectName, newZone.Name)
+		if err != nil {
+			return response.SmartError(err)
+		}
+
+		reverter.Add(func() { _ = netZone.Delete() })
+
+		err = s.Authorizer.AddNetworkZone(r.Context(), zoneProjectName, newZone.Name)
+		if err != nil {
+			logger.Error("Failed to add network zone to authorizer", logger.Ctx{"name": newZone.Name, "project": zoneProjectName, "error": err})
+		}
+
+		s.Events.SendLifecycle(zoneProjectName, lifecycle.NetworkZoneCreated.Event(netZone, request.CreateRequestor(r), nil))
+	}
+
+	// Hand the network definition over to the regular creation path.
+	body, err := json.Marshal(req.Network)
+	if err != nil {
+		return response.InternalError(err)
+	}
+
+	query := r.URL.Query()
+	query.Del("import")
+	query.Del("overwrite")
+	if existing != nil {
+		query.Set("apply", "true")
+	}
+
+	netReq := r.Clone(r.Context())
+	netReq.URL.RawQuery = query.Encode()
+	netReq.Body = io.NopCloser(bytes.NewReader(body))
+	netReq.ContentLength = int64(len(body))
+
+	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
+
+	// Keep the current definition of an overwritten network so it can be restored on failure.
+	var existingPut api.NetworkPut
+	if existing != nil {
+		existingPut = api.NetworkPut{Description: existing.Description(), Config: existing.Config()}
+		if s.ServerClustered {
+			existingPut.Config = db.StripNodeSpecificNetworkConfig(existingPut.Config)
+		}
+	}
+
+	resp := networksPost(d, netReq)
+	if resp.Code() >= http.StatusBadRequest {
+		return resp
+	}
+
+	n, err := network.LoadByName(s, projectName, req.Network.Name)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	if existing == nil {
+		reverter.Add(func() {
+			err := doNetworkDelete(s, r, n, true)
+			if err != nil {
+				logger.Warn("Failed removing imported network", logger.Ctx{"project": projectName, "network": n.Name(), "err": err})
+			}
+		})
+	} else {
+		reverter.Add(func() {
+			n, err := network.LoadByName(s, projectName, req.Network.Name)
+			if err == nil {
+				err = doNetworkUpdate(s, n, existingPut, "", clientType, http.MethodPut, s.ServerClustered, true, true)
+			}
+
+			if err != nil {
+				logger.Warn("Failed restoring overwritten network", logger.Ctx{"project": projectName, "network": req.Network.Name, "err": err})
+			}
+		})
+	}
+
+	if len(req.Forwards) > 0 {
+		if !n.Info().AddressForwards {
+			return response.BadRequest(fmt.Errorf("Network driver %q does not support forwards", n.Type()))
+		}
+
+		currentForwards, err := networkExportForwards(s, r, n)
+		if err != nil {
+			return response.SmartError(err)
+		}
+
+		for _, forward := range req.Forwards {
+			forward.Normalise()
+
+			err = networkImportForward(s, r, n, forward, currentForwards, clientType, reverter)
+			if err != nil {
+				return response.SmartError(err)
+			}
+		}
+	}
+
+	reverter.Success()
+
+	return resp
+}
+
+// networkImportZoneExists returns whether a network zone with the given name exists in any project.
+func networkImportZoneExists(ctx context.Context, s *state.State, zoneName string) (bool, error) {
+	var exists bool
+
+	err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		zones, err := dbCluster.GetNetworkZones(ctx, tx.Tx(), dbCluster.NetworkZoneFilter{Name: &zoneName})
+		if err != nil {
+			return err
+		}
+
+		exists = len(zones) > 0
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// networkImportForward creates or updates an imported forward of the network, adding the steps undoing it to the
+// reverter. Forwards which are specific to a cluster member (such as those of bridge networks) are set up on the
+// member they were exported from, others on the member handling the request.
+func networkImportForward(s *state.State, r *http.Request, n network.Network, forward api.NetworkForward, currentForwards []api.NetworkForward, clientType clusterRequest.ClientType, reverter *revert.Reverter) error {
+	requestor := request.CreateRequestor(r)
+
+	idx := slices.IndexFunc(currentForwards, func(f api.NetworkForward) bool {
+		return f.ListenAddress == forward.ListenAddress && (!s.ServerClustered || f.Location == forward.Location)
+	})
+
+	if s.ServerClustered && forward.Location != "" && forward.Location != s.ServerName {
+		address, err := cluster.ResolveTarget(r.Context(), s, forward.Location)
+		if err != nil {
+			return fmt.Errorf("Failed resolving cluster member %q of forward %q: %w", forward.Location, forward.ListenAddress, err)
+		}
+
+		client, err := cluster.Connect(address, s.Endpoints.NetworkCert(), s.ServerCert(), r, true)
+		if err != nil {
+			return fmt.Errorf("Failed connecting to cluster member %q: %w", forward.Location, err)
+		}
+
+		client = client.UseProject(n.Project())
+
+		if idx >= 0 {
+			current := currentForwards[idx]
+
+			err = client.UpdateNetworkForward(n.Name(), forward.ListenAddress, forward.NetworkForwardPut, "")
+			if err != nil {
+				return fmt.Errorf("Failed updating forward %q on member %q: %w", forward.ListenAddress, forward.Location, err)
+			}
+
+			reverter.Add(func() { _ = client.UpdateNetworkForward(n.Name(), current.ListenAddress, current.Writable(), "") })
+
+			return nil
+		}
+
+		err = client.CreateNetworkForward(n.Name(), api.NetworkForwardsPost{ListenAddress: forward.ListenAddress, NetworkForwardPut: forward.NetworkForwardPut})
+		if err != nil {
+			return fmt.Errorf("Failed creating forward %q on member %q: %w", forward.ListenAddress, forward.Location, err)
+		}
+
+		reverter.Add(func() { _ = client.DeleteNetworkForward(n.Name(), forward.ListenAddress) })
+
+		return nil
+	}
+
+	if idx >= 0 {
+		current := currentForwards[idx]
+
+		err := n.ForwardUpdate(forward.ListenAddress, forward.NetworkForwardPut, clientType)
+		if err != nil {
+			return fmt.Errorf("Failed updating forward %q: %w", forward.ListenAddress, err)
+		}
+
+		reverter.Add(func() { _ = n.ForwardUpdate(current.ListenAddress, current.Writable(), clientType) })
+
+		s.Events.SendLifecycle(n.Project(), lifecycle.NetworkForwardUpdated.Event(n, forward.ListenAddress, requestor, nil))
+
+		return nil
+	}
+
+	err := n.ForwardCreate(api.NetworkForwardsPost{ListenAddress: forward.ListenAddress, NetworkForwardPut: forward.NetworkForwardPut}, clientType)
+	if err != nil {
+		return fmt.Errorf("Failed creating forward %q: %w", forward.ListenAddress, err)
+	}
+
+	reverter.Add(func() { _ = n.ForwardDelete(forward.ListenAddress, clientType) })
+
+	s.Events.SendLifecycle(n.Project(), lifecycle.NetworkForwardCreated.Event(n, forward.ListenAddress, requestor, nil))
+
+	return nil
+}
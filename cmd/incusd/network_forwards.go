@@ -165,7 +165,7 @@ func networkForwardsGet(d *Daemon, r *http.Request) response.Response {
 
 	// Check if project allows access to network.
 	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
 	if !n.Info().AddressForwards {
@@ -331,7 +331,7 @@ func networkForwardsPost(d *Daemon, r *http.Request) response.Response {
 
 	// Check if project allows access to network.
 	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
 	if !n.Info().AddressForwards {
@@ -400,7 +400,7 @@ func networkForwardDelete(d *Daemon, r *http.Request) response.Response {
 
 	// Check if project allows access to network.
 	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
 	if !n.Info().AddressForwards {
@@ -489,7 +489,7 @@ func networkForwardGet(d *Daemon, r *http.Request) response.Response {
 
 	// Check if project allows access to network.
 	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
 	if !n.Info().AddressForwards {
@@ -642,7 +642,7 @@ func networkForwardPut(d *Daemon, r *http.Request) response.Response {
 
 	// Check if project allows access to network.
 	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
 	if !n.Info().AddressForwards {
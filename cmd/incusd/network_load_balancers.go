@@ -171,7 +171,7 @@ func networkLoadBalancersGet(d *Daemon, r *http.Request) response.Response {
 
 	// Check if project allows access to network.
 	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
 	if !n.Info().LoadBalancers {
@@ -341,7 +341,7 @@ func networkLoadBalancersPost(d *Daemon, r *http.Request) response.Response {
 
 	// Check if project allows access to network.
 	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
 	if !n.Info().LoadBalancers {
@@ -410,7 +410,7 @@ func networkLoadBalancerDelete(d *Daemon, r *http.Request) response.Response {
 
 	// Check if project allows access to network.
 	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
 	if !n.Info().LoadBalancers {
@@ -499,7 +499,7 @@ func networkLoadBalancerGet(d *Daemon, r *http.Request) response.Response {
 
 	// Check if project allows access to network.
 	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
 	if !n.Info().LoadBalancers {
@@ -637,7 +637,7 @@ func networkLoadBalancerPut(d *Daemon, r *http.Request) response.Response {
 
 	// Check if project allows access to network.
 	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
 	if !n.Info().LoadBalancers {
@@ -788,7 +788,7 @@ func networkLoadBalancerStateGet(d *Daemon, r *http.Request) response.Response {
 
 	// Check if project allows access to network.
 	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
 	if !n.Info().LoadBalancers {
@@ -164,7 +164,7 @@ func networkPeersGet(d *Daemon, r *http.Request) response.Response {
 
 	// Check if project allows access to network.
 	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
 	if !n.Info().Peering {
@@ -333,7 +333,7 @@ func networkPeersPost(d *Daemon, r *http.Request) response.Response {
 
 	// Check if project allows access to network.
 	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
 	if !n.Info().Peering {
@@ -400,7 +400,7 @@ func networkPeerDelete(d *Daemon, r *http.Request) response.Response {
 
 	// Check if project allows access to network.
 	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
 	if !n.Info().Peering {
@@ -487,7 +487,7 @@ func networkPeerGet(d *Daemon, r *http.Request) response.Response {
 
 	// Check if project allows access to network.
 	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
 	if !n.Info().Peering {
@@ -618,7 +618,7 @@ func networkPeerPut(d *Daemon, r *http.Request) response.Response {
 
 	// Check if project allows access to network.
 	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
 	if !n.Info().Peering {
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/lxc/incus/v6/internal/server/db"
+	"github.com/lxc/incus/v6/internal/server/metadata"
+	"github.com/lxc/incus/v6/internal/server/network"
+	"github.com/lxc/incus/v6/internal/server/response"
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+var networkTypesCmd = APIEndpoint{
+	Path: "network-types",
+
+	Get: APIEndpointAction{Handler: networkTypesGet, AccessHandler: allowAuthenticated},
+}
+
+var networkTypeDefaultsCmd = APIEndpoint{
+	Path: "network-types/{type}/defaults",
+
+	Get: APIEndpointAction{Handler: networkTypeDefaultsGet, AccessHandler: allowAuthenticated},
+}
+
+// swagger:operation GET /1.0/network-types network-types network_types_get
+//
+//	Get the supported network types
+//
+//	Returns the network types supported by the server along with their capabilities and configuration keys.
+//
+//	---
+//	produces:
+//	  - application/json
+//	responses:
+//	  "200":
+//	    description: API endpoints
+//	    schema:
+//	      type: object
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          type: array
+//	          description: List of network types
+//	          items:
+//	            $ref: "#/definitions/NetworkType"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkTypesGet(_ *Daemon, _ *http.Request) response.Response {
+	result := make([]api.NetworkType, 0)
+
+	for _, typeName := range network.Types() {
+		netType, err := network.LoadByType(typeName)
+		if err != nil {
+			return response.InternalError(err)
+		}
+
+		info := netType.Info()
+
+		result = append(result, api.NetworkType{
+			Name:               typeName,
+			Projects:           info.Projects,
+			NodeSpecificConfig: info.NodeSpecificConfig,
+			AddressForwards:    info.AddressForwards,
+			LoadBalancers:      info.LoadBalancers,
+			Peering:            info.Peering,
+			Config:             networkTypeConfigKeys(typeName),
+		})
+	}
+
+	return response.SyncResponse(true, result)
+}
+
+// networkTypeConfigKeys returns the configuration keys of the network type from the generated config metadata.
+func networkTypeConfigKeys(typeName string) []api.NetworkTypeConfigKey {
+	keys := []api.NetworkTypeConfigKey{}
+
+	configs, _ := metadata.Data["configs"].(map[string]any)
+	groups, _ := configs["network_"+typeName].(map[string]any)
+	for _, group := range groups {
+		groupKeys, _ := group.(map[string]any)["keys"].([]any)
+		for _, entry := range groupKeys {
+			entryMap, _ := entry.(map[string]any)
+			for key, value := range entryMap {
+				fields, _ := value.(map[string]any)
+				field := func(name string) string {
+					fieldValue, _ := fields[name].(string)
+					return fieldValue
+				}
+
+				defaultValue := field("defaultdesc")
+				if defaultValue == "" {
+					defaultValue = field("default")
+				}
+
+				keys = append(keys, api.NetworkTypeConfigKey{
+					Key:          key,
+					Type:         field("type"),
+					Default:      defaultValue,
+					Description:  field("shortdesc"),
+					Condition:    field("condition"),
+					NodeSpecific: db.IsNodeSpecificNetworkConfig(key),
+				})
+			}
+		}
+	}
+
+	slices.SortFunc(keys, func(a api.NetworkTypeConfigKey, b api.NetworkTypeConfigKey) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return keys
+}
+
+// swagger:operation GET /1.0/network-types/{type}/defaults network-types network_type_defaults_get
+//
+//	Get the default configuration of a network type
+//
+//	Returns the configuration that would be filled in when creating a network of this type without any configuration.
+//	This is purely advisory, any automatically selected value (such as a subnet) isn't reserved and a different one
+//	may be picked when the network actually gets created.
+//
+//	---
+//	produces:
+//	  - application/json
+//	responses:
+//	  "200":
+//	    description: Default configuration
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          type: object
+//	          additionalProperties:
+//	            type: string
+//	          description: Default configuration keys
+//	          example: {"ipv4.address": "10.33.125.1/24", "ipv4.nat": "true"}
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "404":
+//	    $ref: "#/responses/NotFound"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkTypeDefaultsGet(_ *Daemon, r *http.Request) response.Response {
+	typeName, err := url.PathUnescape(mux.Vars(r)["type"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	netType, err := network.LoadByType(typeName)
+	if err != nil {
+		if errors.Is(err, network.ErrUnknownDriver) {
+			return response.NotFound(fmt.Errorf("Unknown network type %q", typeName))
+		}
+
+		return response.SmartError(err)
+	}
+
+	// The driver isn't tied to an existing network so nothing gets allocated here.
+	config := map[string]string{}
+	err = netType.FillConfig(config)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	return response.SyncResponse(true, config)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
@@ -9,6 +10,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"runtime"
 	"slices"
 	"strconv"
 	"strings"
@@ -16,6 +18,8 @@ import (
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"golang.org/x/sync/errgroup"
 
 	incus "github.com/lxc/incus/v6/client"
 	"github.com/lxc/incus/v6/internal/filter"
@@ -24,11 +28,15 @@ import (
 	clusterRequest "github.com/lxc/incus/v6/internal/server/cluster/request"
 	"github.com/lxc/incus/v6/internal/server/db"
 	dbCluster "github.com/lxc/incus/v6/internal/server/db/cluster"
+	"github.com/lxc/incus/v6/internal/server/db/operationtype"
 	"github.com/lxc/incus/v6/internal/server/db/warningtype"
+	"github.com/lxc/incus/v6/internal/server/events"
 	"github.com/lxc/incus/v6/internal/server/instance"
 	"github.com/lxc/incus/v6/internal/server/instance/instancetype"
 	"github.com/lxc/incus/v6/internal/server/lifecycle"
+	"github.com/lxc/incus/v6/internal/server/locking"
 	"github.com/lxc/incus/v6/internal/server/network"
+	"github.com/lxc/incus/v6/internal/server/operations"
 	"github.com/lxc/incus/v6/internal/server/project"
 	"github.com/lxc/incus/v6/internal/server/request"
 	"github.com/lxc/incus/v6/internal/server/resources"
@@ -41,16 +49,15 @@ import (
 	"github.com/lxc/incus/v6/shared/logger"
 	"github.com/lxc/incus/v6/shared/revert"
 	"github.com/lxc/incus/v6/shared/util"
+	"github.com/lxc/incus/v6/shared/ws"
 )
 
-// Lock to prevent concurrent networks creation.
-var networkCreateLock sync.Mutex
-
 var networksCmd = APIEndpoint{
 	Path: "networks",
 
-	Get:  APIEndpointAction{Handler: networksGet, AccessHandler: allowAuthenticated},
-	Post: APIEndpointAction{Handler: networksPost, AccessHandler: allowPermission(auth.ObjectTypeProject, auth.EntitlementCanCreateNetworks)},
+	Delete: APIEndpointAction{Handler: networksDelete, AccessHandler: allowAuthenticated},
+	Get:    APIEndpointAction{Handler: networksGet, AccessHandler: allowAuthenticated},
+	Post:   APIEndpointAction{Handler: networksPost, AccessHandler: allowPermission(auth.ObjectTypeProject, auth.EntitlementCanCreateNetworks)},
 }
 
 var networkCmd = APIEndpoint{
@@ -63,10 +70,35 @@ var networkCmd = APIEndpoint{
 	Put:    APIEndpointAction{Handler: networkPut, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
 }
 
+var networkLeaseCmd = APIEndpoint{
+	Path: "networks/{networkName}/leases/{address}",
+
+	Delete: APIEndpointAction{Handler: networkLeaseDelete, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+}
+
 var networkLeasesCmd = APIEndpoint{
 	Path: "networks/{networkName}/leases",
 
-	Get: APIEndpointAction{Handler: networkLeasesGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
+	Get:  APIEndpointAction{Handler: networkLeasesGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
+	Post: APIEndpointAction{Handler: networkLeasesPost, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+}
+
+var networkReservationsCmd = APIEndpoint{
+	Path: "networks/{networkName}/reservations",
+
+	Post: APIEndpointAction{Handler: networkReservationsPost, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+}
+
+var networkValidateCmd = APIEndpoint{
+	Path: "networks/{networkName}/validate",
+
+	Post: APIEndpointAction{Handler: networkValidatePost, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+}
+
+var networkRestartCmd = APIEndpoint{
+	Path: "networks/{networkName}/restart",
+
+	Post: APIEndpointAction{Handler: networkRestartPost, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
 }
 
 var networkStateCmd = APIEndpoint{
@@ -75,6 +107,36 @@ var networkStateCmd = APIEndpoint{
 	Get: APIEndpointAction{Handler: networkStateGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
 }
 
+var networkStatsCmd = APIEndpoint{
+	Path: "networks/{networkName}/stats",
+
+	Get: APIEndpointAction{Handler: networkStatsGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
+}
+
+var networkConnectivityCmd = APIEndpoint{
+	Path: "networks/{networkName}/connectivity",
+
+	Get: APIEndpointAction{Handler: networkConnectivityGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
+}
+
+var networkEventsCmd = APIEndpoint{
+	Path: "networks/{networkName}/events",
+
+	Get: APIEndpointAction{Handler: networkEventsGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
+}
+
+var networkRecreateCmd = APIEndpoint{
+	Path: "networks/{networkName}/recreate",
+
+	Post: APIEndpointAction{Handler: networkRecreatePost, AccessHandler: allowPermission(auth.ObjectTypeServer, auth.EntitlementCanEdit)},
+}
+
+var networkRegenerateCmd = APIEndpoint{
+	Path: "networks/{networkName}/regenerate",
+
+	Post: APIEndpointAction{Handler: networkRegeneratePost, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+}
+
 // API endpoints
 
 // swagger:operation GET /1.0/networks networks networks_get
@@ -94,14 +156,54 @@ var networkStateCmd = APIEndpoint{
 //      example: default
 //    - in: query
 //      name: all-projects
-//      description: Retrieve networks from all projects
+//      description: Retrieve networks from all projects (restricted to the projects listed in the project parameter, if any)
 //      type: boolean
 //      example: true
 //    - in: query
 //      name: filter
-//      description: Collection filter
+//      description: Collection filter (the derived "used" field is true for networks in use, "address_families" lists the configured IP families)
 //      type: string
-//      example: default
+//      example: used=true
+//    - in: query
+//      name: created-after
+//      description: Only return networks created after this time (RFC3339)
+//      type: string
+//      example: 2025-01-01T00:00:00Z
+//    - in: query
+//      name: created-before
+//      description: Only return networks created before this time (RFC3339)
+//      type: string
+//      example: 2025-12-31T23:59:59Z
+//    - in: query
+//      name: uses-acl
+//      description: Only return networks referencing this ACL in their security.acls
+//      type: string
+//      example: web
+//    - in: query
+//      name: limit
+//      description: Maximum number of networks to return (the total number of networks is returned in the X-Incus-Total header)
+//      type: integer
+//      example: 100
+//    - in: query
+//      name: offset
+//      description: Number of networks to skip, networks being sorted by project and name
+//      type: integer
+//      example: 200
+//    - in: query
+//      name: sort
+//      description: Sort the networks by "name" or by "used_by" (most used first)
+//      type: string
+//      example: used_by
+//    - in: query
+//      name: check-subnet
+//      description: Instead of listing networks, check whether the subnet overlaps the addressing of existing networks (returns a NetworkSubnetCheck)
+//      type: string
+//      example: 10.0.5.0/24
+//    - in: query
+//      name: uuid
+//      description: Instead of listing networks, return the network with this UUID, in whichever project it is
+//      type: string
+//      example: 4ab8e2a6-47cc-4a5b-8c1c-3a52e1a0b1f4
 //  responses:
 //    "200":
 //      description: API endpoints
@@ -153,14 +255,59 @@ var networkStateCmd = APIEndpoint{
 //      example: default
 //    - in: query
 //      name: all-projects
-//      description: Retrieve networks from all projects
+//      description: Retrieve networks from all projects (restricted to the projects listed in the project parameter, if any)
 //      type: boolean
 //      example: true
 //    - in: query
 //      name: filter
-//      description: Collection filter
+//      description: Collection filter (the derived "used" field is true for networks in use, "address_families" lists the configured IP families)
 //      type: string
-//      example: default
+//      example: used=true
+//    - in: query
+//      name: created-after
+//      description: Only return networks created after this time (RFC3339)
+//      type: string
+//      example: 2025-01-01T00:00:00Z
+//    - in: query
+//      name: created-before
+//      description: Only return networks created before this time (RFC3339)
+//      type: string
+//      example: 2025-12-31T23:59:59Z
+//    - in: query
+//      name: uses-acl
+//      description: Only return networks referencing this ACL in their security.acls
+//      type: string
+//      example: web
+//    - in: query
+//      name: limit
+//      description: Maximum number of networks to return (the total number of networks is returned in the X-Incus-Total header)
+//      type: integer
+//      example: 100
+//    - in: query
+//      name: offset
+//      description: Number of networks to skip, networks being sorted by project and name
+//      type: integer
+//      example: 200
+//    - in: query
+//      name: sort
+//      description: Sort the networks by "name" or by "used_by" (most used first)
+//      type: string
+//      example: used_by
+//    - in: query
+//      name: check-subnet
+//      description: Instead of listing networks, check whether the subnet overlaps the addressing of existing networks (returns a NetworkSubnetCheck)
+//      type: string
+//      example: 10.0.5.0/24
+//    - in: query
+//      name: uuid
+//      description: Instead of listing networks, return the network with this UUID, in whichever project it is
+//      type: string
+//      example: 4ab8e2a6-47cc-4a5b-8c1c-3a52e1a0b1f4
+//    - in: query
+//      name: group-by
+//      description: Group the networks (currently only "uplink", returns a list of NetworkUplinkGroup with OVN networks nested under their uplink)
+//      type: string
+//      example: uplink
 //  responses:
 //    "200":
 //      description: API endpoints
@@ -193,13 +340,58 @@ var networkStateCmd = APIEndpoint{
 func networksGet(d *Daemon, r *http.Request) response.Response {
 	s := d.State()
 
-	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	checkSubnet := request.QueryParam(r, "check-subnet")
+	if checkSubnet != "" {
+		return networksCheckSubnet(s, r, checkSubnet)
+	}
+
+	networkUUID := request.QueryParam(r, "uuid")
+	if networkUUID != "" {
+		return networksGetByUUID(s, r, networkUUID)
+	}
+
+	allProjects := util.IsTrue(r.FormValue("all-projects"))
+
+	// When listing all projects, the project parameter can be repeated or be a comma-separated list to
+	// restrict the listing to those projects.
+	projectParam := request.ProjectParam(r)
+	filterProjects := []string{}
+	if allProjects {
+		for _, value := range r.URL.Query()["project"] {
+			for _, name := range util.SplitNTrimSpace(value, ",", -1, true) {
+				// Networks of projects without their own networks live in the default project.
+				effectiveProjectName, _, err := project.NetworkProject(s.DB.Cluster, name)
+				if err != nil {
+					return response.SmartError(fmt.Errorf("Failed loading project %q: %w", name, err))
+				}
+
+				if !slices.Contains(filterProjects, effectiveProjectName) {
+					filterProjects = append(filterProjects, effectiveProjectName)
+				}
+			}
+		}
+
+		if len(filterProjects) > 0 {
+			projectParam = api.ProjectDefaultName
+		}
+	}
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, projectParam)
 	if err != nil {
 		return response.SmartError(err)
 	}
 
 	recursion := localUtil.IsRecursionRequest(r)
 
+	groupBy := request.QueryParam(r, "group-by")
+	if groupBy != "" && groupBy != "uplink" {
+		return response.BadRequest(fmt.Errorf("Invalid group-by value %q", groupBy))
+	}
+
+	if groupBy != "" && !recursion {
+		return response.BadRequest(errors.New("The group-by option requires recursion"))
+	}
+
 	// Parse filter value.
 	filterStr := r.FormValue("filter")
 	clauses, err := filter.Parse(filterStr, filter.QueryOperatorSet())
@@ -207,9 +399,69 @@ func networksGet(d *Daemon, r *http.Request) response.Response {
 		return response.BadRequest(fmt.Errorf("Invalid filter: %w", err))
 	}
 
-	mustLoadObjects := recursion || (clauses != nil && len(clauses.Clauses) > 0)
+	// Parse creation time bounds.
+	var createdAfter, createdBefore time.Time
 
-	allProjects := util.IsTrue(r.FormValue("all-projects"))
+	createdAfterStr := request.QueryParam(r, "created-after")
+	if createdAfterStr != "" {
+		createdAfter, err = time.Parse(time.RFC3339, createdAfterStr)
+		if err != nil {
+			return response.BadRequest(fmt.Errorf("Invalid created-after value: %w", err))
+		}
+	}
+
+	createdBeforeStr := request.QueryParam(r, "created-before")
+	if createdBeforeStr != "" {
+		createdBefore, err = time.Parse(time.RFC3339, createdBeforeStr)
+		if err != nil {
+			return response.BadRequest(fmt.Errorf("Invalid created-before value: %w", err))
+		}
+	}
+
+	filterCreated := !createdAfter.IsZero() || !createdBefore.IsZero()
+
+	usesACL := request.QueryParam(r, "uses-acl")
+
+	// Parse pagination.
+	var limit, offset int
+
+	limitStr := request.QueryParam(r, "limit")
+	if limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 0 {
+			return response.BadRequest(fmt.Errorf("Invalid limit value %q", limitStr))
+		}
+	}
+
+	offsetStr := request.QueryParam(r, "offset")
+	if offsetStr != "" {
+		offset, err = strconv.Atoi(offsetStr)
+		if err != nil || offset < 0 {
+			return response.BadRequest(fmt.Errorf("Invalid offset value %q", offsetStr))
+		}
+	}
+
+	paginated := limitStr != "" || offsetStr != ""
+	if paginated && groupBy != "" {
+		return response.BadRequest(errors.New("Pagination can't be used with the group-by option"))
+	}
+
+	// page returns the bounds of the requested page within the given number of results.
+	page := func(total int) (int, int) {
+		start := min(offset, total)
+		if limitStr == "" {
+			return start, total
+		}
+
+		return start, min(start+limit, total)
+	}
+
+	sortBy := request.QueryParam(r, "sort")
+	if sortBy != "" && sortBy != "name" && sortBy != "used_by" {
+		return response.BadRequest(fmt.Errorf("Invalid sort value %q", sortBy))
+	}
+
+	mustLoadObjects := recursion || (clauses != nil && len(clauses.Clauses) > 0) || filterCreated || usesACL != "" || sortBy == "used_by"
 
 	var networkNames map[string][]string
 
@@ -245,7 +497,7 @@ func networksGet(d *Daemon, r *http.Request) response.Response {
 		}
 
 		for _, iface := range ifaces {
-			// Ignore veth pairs (for performance reasons).
+			// Ignore veth pairs (for performance reasons), managed networks are already in the list.
 			if strings.HasPrefix(iface.Name, "veth") {
 				continue
 			}
@@ -257,607 +509,698 @@ func networksGet(d *Daemon, r *http.Request) response.Response {
 		}
 	}
 
+	// Only keep the requested projects.
+	if len(filterProjects) > 0 {
+		maps.DeleteFunc(networkNames, func(name string, _ []string) bool {
+			return !slices.Contains(filterProjects, name)
+		})
+	}
+
 	userHasPermission, err := s.Authorizer.GetPermissionChecker(r.Context(), r, auth.EntitlementCanView, auth.ObjectTypeNetwork)
 	if err != nil {
 		return response.InternalError(err)
 	}
 
-	linkResults := make([]string, 0)
-	fullResults := make([]api.Network, 0)
-	for projectName, networks := range networkNames {
-		for _, networkName := range networks {
+	type networkEntry struct {
+		projectName string
+		networkName string
+	}
+
+	entries := []networkEntry{}
+	for _, projectName := range slices.Sorted(maps.Keys(networkNames)) {
+		for _, networkName := range slices.Sorted(slices.Values(networkNames[projectName])) {
 			if !userHasPermission(auth.ObjectNetwork(projectName, networkName)) {
 				continue
 			}
 
-			if mustLoadObjects {
-				netInfo, err := doNetworkGet(s, r, s.ServerClustered, projectName, reqProject.Config, networkName)
+			if !mustLoadObjects && !project.NetworkAllowed(reqProject.Config, networkName, true) {
+				continue
+			}
+
+			entries = append(entries, networkEntry{projectName: projectName, networkName: networkName})
+		}
+	}
+
+	// Sorting by name spans projects, the networks are otherwise sorted by project first.
+	if sortBy == "name" {
+		slices.SortStableFunc(entries, func(a networkEntry, b networkEntry) int {
+			return cmp.Or(strings.Compare(a.networkName, b.networkName), strings.Compare(a.projectName, b.projectName))
+		})
+	}
+
+	// Without filters the page can be selected before loading the networks.
+	// When sorting by usage, the networks must be loaded to be ordered.
+	filtered := (clauses != nil && len(clauses.Clauses) > 0) || filterCreated || usesACL != "" || sortBy == "used_by"
+	total := len(entries)
+	if paginated && !filtered {
+		start, end := page(total)
+		entries = entries[start:end]
+	}
+
+	// Load the networks in parallel, only keeping the matching ones.
+	// The resources using the networks are only needed when returned or filtered on.
+	withUsedBy := recursion || networkFilterUsesUsedBy(clauses) || sortBy == "used_by"
+	loaded := make([]*api.Network, len(entries))
+	if mustLoadObjects {
+		cache, err := loadNetworkListCache(r.Context(), s)
+		if err != nil {
+			return response.SmartError(err)
+		}
+
+		group := &errgroup.Group{}
+		group.SetLimit(max(runtime.NumCPU(), 4))
+
+		for i, entry := range entries {
+			group.Go(func() error {
+				netInfo, err := doNetworkGet(s, r, s.ServerClustered, withUsedBy, entry.projectName, reqProject.Config, entry.networkName, cache)
 				if err != nil {
-					continue
+					return nil
+				}
+
+				if filterCreated {
+					// Unmanaged networks have no creation time.
+					if !netInfo.Managed {
+						return nil
+					}
+
+					if !createdAfter.IsZero() && !netInfo.CreatedAt.After(createdAfter) {
+						return nil
+					}
+
+					if !createdBefore.IsZero() && !netInfo.CreatedAt.Before(createdBefore) {
+						return nil
+					}
+				}
+
+				if usesACL != "" && !slices.Contains(util.SplitNTrimSpace(netInfo.Config["security.acls"], ",", -1, true), usesACL) {
+					return nil
 				}
 
 				if clauses != nil && len(clauses.Clauses) > 0 {
-					match, err := filter.Match(netInfo, *clauses)
+					match, err := filter.Match(networkFilterObject(netInfo), *clauses)
 					if err != nil {
-						return response.SmartError(err)
+						return err
 					}
 
 					if !match {
-						continue
+						return nil
 					}
 				}
 
-				fullResults = append(fullResults, netInfo)
-			} else {
-				if !project.NetworkAllowed(reqProject.Config, networkName, true) {
-					continue
-				}
-			}
+				loaded[i] = &netInfo
 
-			linkResults = append(linkResults, fmt.Sprintf("/%s/networks/%s", version.APIVersion, networkName))
+				return nil
+			})
 		}
-	}
 
-	if !recursion {
-		return response.SyncResponse(true, linkResults)
+		err = group.Wait()
+		if err != nil {
+			return response.SmartError(err)
+		}
 	}
 
-	return response.SyncResponse(true, fullResults)
-}
+	// Order the most used networks first, keeping the name order between networks equally used.
+	order := make([]int, 0, len(entries))
+	for i := range entries {
+		if mustLoadObjects && loaded[i] == nil {
+			continue
+		}
 
-// swagger:operation POST /1.0/networks networks networks_post
-//
-//	Add a network
-//
-//	Creates a new network.
-//	When clustered, most network types require individual POST for each cluster member prior to a global POST.
-//
-//	---
-//	consumes:
-//	  - application/json
-//	produces:
-//	  - application/json
-//	parameters:
-//	  - in: query
-//	    name: project
-//	    description: Project name
-//	    type: string
-//	    example: default
-//	  - in: query
-//	    name: target
-//	    description: Cluster member name
-//	    type: string
-//	    example: server01
-//	  - in: body
-//	    name: network
-//	    description: Network
-//	    required: true
-//	    schema:
-//	      $ref: "#/definitions/NetworksPost"
-//	responses:
-//	  "200":
-//	    $ref: "#/responses/EmptySyncResponse"
-//	  "400":
-//	    $ref: "#/responses/BadRequest"
-//	  "403":
-//	    $ref: "#/responses/Forbidden"
-//	  "500":
-//	    $ref: "#/responses/InternalServerError"
-func networksPost(d *Daemon, r *http.Request) response.Response {
-	s := d.State()
+		order = append(order, i)
+	}
 
-	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
-	if err != nil {
-		return response.SmartError(err)
+	if sortBy == "used_by" {
+		slices.SortStableFunc(order, func(a int, b int) int {
+			return cmp.Compare(len(loaded[b].UsedBy), len(loaded[a].UsedBy))
+		})
 	}
 
-	networkCreateLock.Lock()
-	defer networkCreateLock.Unlock()
+	linkResults := make([]string, 0)
+	fullResults := make([]api.Network, 0)
+	for _, i := range order {
+		entry := entries[i]
 
-	req := api.NetworksPost{}
+		if mustLoadObjects {
+			fullResults = append(fullResults, *loaded[i])
+		}
 
-	// Parse the request.
-	err = json.NewDecoder(r.Body).Decode(&req)
-	if err != nil {
-		return response.BadRequest(err)
-	}
+		// Include the project in the URLs when listing all projects so that the links are unambiguous.
+		u := api.NewURL().Path(version.APIVersion, "networks", entry.networkName)
+		if allProjects {
+			u = u.Project(entry.projectName)
+		}
 
-	// Quick checks.
-	if req.Name == "" {
-		return response.BadRequest(errors.New("No name provided"))
+		linkResults = append(linkResults, u.String())
 	}
 
-	if req.Name == "none" {
-		return response.BadRequest(errors.New("Network name 'none' is not valid"))
-	}
+	var headers map[string]string
+	if paginated {
+		// With filters the networks had to be loaded to find the matching ones, select the page now.
+		if filtered {
+			total = len(linkResults)
+			start, end := page(total)
+			linkResults = linkResults[start:end]
+			fullResults = fullResults[start:end]
+		}
 
-	// Check if project allows access to network.
-	if !project.NetworkAllowed(reqProject.Config, req.Name, true) {
-		return response.SmartError(api.StatusErrorf(http.StatusForbidden, "Network not allowed in project"))
+		headers = map[string]string{"X-Incus-Total": strconv.Itoa(total)}
 	}
 
-	if req.Type == "" {
-		if projectName != api.ProjectDefaultName {
-			req.Type = "ovn" // Only OVN networks are allowed inside network enabled projects.
-		} else {
-			req.Type = "bridge" // Default to bridge for non-network enabled projects.
-		}
+	if !recursion {
+		return response.SyncResponseHeaders(true, linkResults, headers)
 	}
 
-	if req.Config == nil {
-		req.Config = map[string]string{}
+	if groupBy == "uplink" {
+		return response.SyncResponse(true, networksGroupByUplink(fullResults))
 	}
 
-	netType, err := network.LoadByType(req.Type)
-	if err != nil {
-		return response.BadRequest(err)
-	}
+	return response.SyncResponseHeaders(true, fullResults, headers)
+}
 
-	err = netType.ValidateName(req.Name)
-	if err != nil {
-		return response.BadRequest(err)
+// networkFilterObject returns the network with its derived fields, used for filtering purposes only.
+func networkFilterObject(netInfo api.Network) any {
+	type filterNetwork struct {
+		api.Network `yaml:",inline"`
+		Used        string `yaml:"used"`
 	}
 
-	netTypeInfo := netType.Info()
-	if projectName != api.ProjectDefaultName && !netTypeInfo.Projects {
-		return response.BadRequest(errors.New("Network type does not support non-default projects"))
+	return filterNetwork{
+		Network: netInfo,
+		Used:    strconv.FormatBool(len(netInfo.UsedBy) > 0),
 	}
+}
 
-	// Check if project has limits.network and if so check we are allowed to create another network.
-	if projectName != api.ProjectDefaultName && reqProject.Config != nil && reqProject.Config["limits.networks"] != "" {
-		networksLimit, err := strconv.Atoi(reqProject.Config["limits.networks"])
-		if err != nil {
-			return response.InternalError(fmt.Errorf("Invalid project limits.network value: %w", err))
+// networkAddressFamilies returns the IP address families configured on a managed network.
+func networkAddressFamilies(config map[string]string) []string {
+	families := []string{}
+	for _, family := range []string{"ipv4", "ipv6"} {
+		address := config[family+".address"]
+		if address == "" || address == "none" {
+			continue
 		}
 
-		var networks []string
-
-		err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
-			networks, err = tx.GetNetworks(ctx, projectName)
-
-			return err
-		})
+		_, _, err := net.ParseCIDR(address)
 		if err != nil {
-			return response.InternalError(fmt.Errorf("Failed loading project's networks for limits check: %w", err))
+			continue
 		}
 
-		// Only check network limits if the new network name doesn't exist already in networks list.
-		// If it does then this create request will either be for adding a target node to an existing
-		// pending network or it will fail anyway as it is a duplicate.
-		if !slices.Contains(networks, req.Name) && len(networks) >= networksLimit {
-			return response.BadRequest(errors.New("Networks limit has been reached for project"))
-		}
+		families = append(families, family)
 	}
 
-	u := api.NewURL().Path(version.APIVersion, "networks", req.Name).Project(projectName)
-
-	resp := response.SyncResponseLocation(true, nil, u.String())
-
-	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
+	return families
+}
 
-	if isClusterNotification(r) {
-		n, err := network.LoadByName(s, projectName, req.Name)
-		if err != nil {
-			return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
-		}
+// networkFilterUsesUsedBy returns whether any of the filter clauses references the resources using the network.
+func networkFilterUsesUsedBy(clauses *filter.ClauseSet) bool {
+	if clauses == nil {
+		return false
+	}
 
-		// This is an internal request which triggers the actual creation of the network across all nodes
-		// after they have been previously defined.
-		err = doNetworksCreate(r.Context(), s, n, clientType)
-		if err != nil {
-			return response.SmartError(err)
+	for _, clause := range clauses.Clauses {
+		if clause.Field == "used" || strings.HasPrefix(clause.Field, "used_by") {
+			return true
 		}
-
-		return resp
 	}
 
-	targetNode := request.QueryParam(r, "target")
-	if targetNode != "" {
-		if !netTypeInfo.NodeSpecificConfig {
-			return response.BadRequest(fmt.Errorf("Network type %q does not support member specific config", netType.Type()))
-		}
+	return false
+}
 
-		// A targetNode was specified, let's just define the node's network without actually creating it.
-		// Check that only NodeSpecificNetworkConfig keys are specified.
-		for key := range req.Config {
-			if !db.IsNodeSpecificNetworkConfig(key) {
-				return response.BadRequest(fmt.Errorf("Config key %q may not be used as member-specific key", key))
-			}
+// networksGroupByUplink nests the OVN networks under the uplink network referenced by their "network" key.
+// All other networks are potential uplinks and are listed at the top level, OVN networks without an uplink are
+// grouped under an entry with an empty name.
+func networksGroupByUplink(networks []api.Network) []api.NetworkUplinkGroup {
+	groups := map[string]*api.NetworkUplinkGroup{}
+
+	getGroup := func(name string) *api.NetworkUplinkGroup {
+		group, ok := groups[name]
+		if !ok {
+			group = &api.NetworkUplinkGroup{Name: name, Overlays: []api.Network{}}
+			groups[name] = group
 		}
 
-		exists := false
-		err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
-			_, err := tx.GetNetworkID(ctx, projectName, req.Name)
-			if err == nil {
-				exists = true
-			}
+		return group
+	}
 
-			return tx.CreatePendingNetwork(ctx, targetNode, projectName, req.Name, req.Description, netType.DBType(), req.Config)
-		})
-		if err != nil {
-			if errors.Is(err, db.ErrAlreadyDefined) {
-				return response.Conflict(fmt.Errorf("Network %q is already defined on member %q", req.Name, targetNode))
+	for _, netInfo := range networks {
+		if netInfo.Type != "ovn" {
+			// Uplink networks are always in the default project.
+			if netInfo.Project != "" && netInfo.Project != api.ProjectDefaultName {
+				continue
 			}
 
-			return response.SmartError(err)
+			getGroup(netInfo.Name).Network = &netInfo
+			continue
 		}
 
-		if !exists {
-			err = s.Authorizer.AddNetwork(r.Context(), projectName, req.Name)
-			if err != nil {
-				logger.Error("Failed to add network to authorizer", logger.Ctx{"name": req.Name, "project": projectName, "error": err})
-			}
-
-			n, err := network.LoadByName(s, projectName, req.Name)
-			if err != nil {
-				return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
-			}
-
-			requestor := request.CreateRequestor(r)
-			s.Events.SendLifecycle(projectName, lifecycle.NetworkCreated.Event(n, requestor, nil))
+		uplinkName := netInfo.Config["network"]
+		if uplinkName == "none" {
+			uplinkName = ""
 		}
 
-		return resp
+		group := getGroup(uplinkName)
+		group.Overlays = append(group.Overlays, netInfo)
 	}
 
-	var netInfo *api.Network
+	result := make([]api.NetworkUplinkGroup, 0, len(groups))
+	for _, name := range slices.Sorted(maps.Keys(groups)) {
+		result = append(result, *groups[name])
+	}
 
-	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
-		// Load existing network if exists, if not don't fail.
-		_, netInfo, _, err = tx.GetNetworkInAnyState(ctx, projectName, req.Name)
+	return result
+}
+
+// networksGetByUUID returns the network with the given UUID as a single entry list, regardless of its project.
+// Networks the requestor can't see are reported as not found.
+func networksGetByUUID(s *state.State, r *http.Request, networkUUID string) response.Response {
+	var networkName, projectName string
+	err := s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+		networkName, projectName, err = tx.GetNetworkNameAndProjectWithUUID(ctx, networkUUID)
 
 		return err
 	})
-	if err != nil && !api.StatusErrorCheck(err, http.StatusNotFound) {
-		return response.InternalError(err)
-	}
-
-	// Check if we're clustered.
-	count, err := cluster.Count(s)
 	if err != nil {
 		return response.SmartError(err)
 	}
 
-	// No targetNode was specified and we're clustered or there is an existing partially created single node
-	// network, either way finalize the config in the db and actually create the network on all cluster nodes.
-	if count > 1 || (netInfo != nil && netInfo.Status != api.NetworkStatusCreated) {
-		// Simulate adding pending node network config when the driver doesn't support per-node config.
-		if !netTypeInfo.NodeSpecificConfig && clientType != clusterRequest.ClientTypeJoiner {
-			// Create pending entry for each node.
-			err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
-				members, err := tx.GetNodes(ctx)
-				if err != nil {
-					return fmt.Errorf("Failed getting cluster members: %w", err)
-				}
-
-				for _, member := range members {
-					// Don't pass in any config, as these nodes don't have any node-specific
-					// config and we don't want to create duplicate global config.
-					err = tx.CreatePendingNetwork(ctx, member.Name, projectName, req.Name, req.Description, netType.DBType(), nil)
-					if err != nil && !errors.Is(err, db.ErrAlreadyDefined) {
-						return fmt.Errorf("Failed creating pending network for member %q: %w", member.Name, err)
-					}
-				}
-
-				return nil
-			})
-			if err != nil {
-				return response.SmartError(err)
-			}
+	err = s.Authorizer.CheckPermission(r.Context(), r, auth.ObjectNetwork(projectName, networkName), auth.EntitlementCanView)
+	if err != nil {
+		if api.StatusErrorCheck(err, http.StatusForbidden) {
+			return response.NotFound(errors.New("Network not found"))
+		}
 
-			// Create the authorization entry and advertise the network as existing.
-			err = s.Authorizer.AddNetwork(r.Context(), projectName, req.Name)
-			if err != nil {
-				logger.Error("Failed to add network to authorizer", logger.Ctx{"name": req.Name, "project": projectName, "error": err})
-			}
+		return response.SmartError(err)
+	}
 
-			n, err := network.LoadByName(s, projectName, req.Name)
-			if err != nil {
-				return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
-			}
+	if !localUtil.IsRecursionRequest(r) {
+		u := api.NewURL().Path(version.APIVersion, "networks", networkName).Project(projectName)
 
-			requestor := request.CreateRequestor(r)
-			s.Events.SendLifecycle(projectName, lifecycle.NetworkCreated.Event(n, requestor, nil))
-		}
+		return response.SyncResponse(true, []string{u.String()})
+	}
 
-		err = networksPostCluster(r.Context(), s, projectName, netInfo, req, clientType, netType)
+	var reqProjectConfig map[string]string
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		p, err := dbCluster.GetProject(ctx, tx.Tx(), projectName)
 		if err != nil {
-			return response.SmartError(err)
+			return err
 		}
 
-		return resp
+		reqProjectConfig, err = dbCluster.GetProjectConfig(ctx, tx.Tx(), p.ID)
+
+		return err
+	})
+	if err != nil {
+		return response.SmartError(err)
 	}
 
-	// Non-clustered network creation.
-	if netInfo != nil {
-		return response.Conflict(fmt.Errorf("Network %q already exists", req.Name))
+	netInfo, err := doNetworkGet(s, r, s.ServerClustered, true, projectName, reqProjectConfig, networkName, nil)
+	if err != nil {
+		return response.SmartError(err)
 	}
 
-	reverter := revert.New()
-	defer reverter.Fail()
+	return response.SyncResponse(true, []api.Network{netInfo})
+}
 
-	// Populate default config.
-	if clientType != clusterRequest.ClientTypeJoiner {
-		err = netType.FillConfig(req.Config)
-		if err != nil {
-			return response.SmartError(err)
-		}
+// networksCheckSubnet reports whether the subnet overlaps the addresses or routes of the managed networks the
+// requestor can see.
+func networksCheckSubnet(s *state.State, r *http.Request, subnetStr string) response.Response {
+	_, subnet, err := net.ParseCIDR(subnetStr)
+	if err != nil {
+		return response.BadRequest(fmt.Errorf("Invalid check-subnet value: %w", err))
 	}
 
+	var projectNetworks map[string]map[int64]api.Network
 	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
-		// Create the database entry.
-		_, err = tx.CreateNetwork(ctx, projectName, req.Name, req.Description, netType.DBType(), req.Config)
+		projectNetworks, err = tx.GetCreatedNetworks(ctx)
 
 		return err
 	})
 	if err != nil {
-		return response.SmartError(fmt.Errorf("Error inserting %q into database: %w", req.Name, err))
+		return response.SmartError(err)
 	}
 
-	reverter.Add(func() {
-		_ = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
-			return tx.DeleteNetwork(ctx, projectName, req.Name)
-		})
-	})
-
-	n, err := network.LoadByName(s, projectName, req.Name)
+	userHasPermission, err := s.Authorizer.GetPermissionChecker(r.Context(), r, auth.EntitlementCanView, auth.ObjectTypeNetwork)
 	if err != nil {
-		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+		return response.InternalError(err)
 	}
 
-	err = doNetworksCreate(r.Context(), s, n, clientType)
-	if err != nil {
-		return response.SmartError(err)
+	// Only consider the networks the requestor can see.
+	for projectName, networks := range projectNetworks {
+		maps.DeleteFunc(networks, func(_ int64, netInfo api.Network) bool {
+			return !userHasPermission(auth.ObjectNetwork(projectName, netInfo.Name))
+		})
 	}
 
-	err = s.Authorizer.AddNetwork(r.Context(), projectName, req.Name)
-	if err != nil {
-		logger.Error("Failed to add network to authorizer", logger.Ctx{"name": req.Name, "project": projectName, "error": err})
+	result := api.NetworkSubnetCheck{
+		Subnet:    subnet.String(),
+		Conflicts: networkSubnetConflicts(projectNetworks, subnet),
 	}
 
-	requestor := request.CreateRequestor(r)
-	s.Events.SendLifecycle(projectName, lifecycle.NetworkCreated.Event(n, requestor, nil))
+	result.Available = len(result.Conflicts) == 0
 
-	reverter.Success()
-	return resp
+	return response.SyncResponse(true, result)
 }
 
-// networkPartiallyCreated returns true of supplied network has properties that indicate it has had previous
-// create attempts run on it but failed on one or more nodes.
-func networkPartiallyCreated(netInfo *api.Network) bool {
-	// If the network status is NetworkStatusErrored, this means create has been run in the past and has
-	// failed on one or more nodes. Hence it is partially created.
-	if netInfo.Status == api.NetworkStatusErrored {
-		return true
-	}
+// swagger:operation DELETE /1.0/networks networks networks_delete
+//
+//	Delete the networks matching a filter
+//
+//	Removes all the managed networks matching the filter which the caller can edit and which aren't in use.
+//	Each network is checked and deleted independently, the result lists the outcome for each of them.
+//	Alternatively, the networks to delete can be listed by name, in which case the request is rejected unless all
+//	of them can be deleted.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: query
+//	    name: filter
+//	    description: Collection filter (required unless networks are listed with name)
+//	    type: string
+//	    example: description eq tenant1
+//	  - in: query
+//	    name: name
+//	    description: Network to delete, can be repeated (all the networks are checked before any gets deleted)
+//	    type: string
+//	    example: tenant1-net
+//	responses:
+//	  "200":
+//	    description: Deletion results
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          type: array
+//	          description: Result for each matching network
+//	          items:
+//	            $ref: "#/definitions/NetworksDeleteResult"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networksDelete(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
 
-	// If the network has global config keys, then it has previously been created by having its global config
-	// inserted, and this means it is partialled created.
-	for key := range netInfo.Config {
-		if !db.IsNodeSpecificNetworkConfig(key) {
-			return true
-		}
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
 	}
 
-	return false
-}
-
-// networksPostCluster checks that there is a pending network in the database and then attempts to setup the
-// network on each node. If all nodes are successfully setup then the network's state is set to created.
-// Accepts an optional existing network record, which will exist when performing subsequent re-create attempts.
-func networksPostCluster(ctx context.Context, s *state.State, projectName string, netInfo *api.Network, req api.NetworksPost, clientType clusterRequest.ClientType, netType network.Type) error {
-	// Check that no node-specific config key has been supplied in request.
-	for key := range req.Config {
-		if db.IsNodeSpecificNetworkConfig(key) {
-			return fmt.Errorf("Config key %q is cluster member specific", key)
-		}
+	// Require a filter so that a bare DELETE can't wipe out all the networks.
+	clauses, err := filter.Parse(r.FormValue("filter"), filter.QueryOperatorSet())
+	if err != nil {
+		return response.BadRequest(fmt.Errorf("Invalid filter: %w", err))
 	}
 
-	// If network already exists, perform quick checks.
-	if netInfo != nil {
-		// Check network isn't already created.
-		if netInfo.Status == api.NetworkStatusCreated {
-			return errors.New("The network is already created")
+	// Delete the listed networks instead if requested.
+	names := r.URL.Query()["name"]
+	if len(names) > 0 {
+		if clauses != nil && len(clauses.Clauses) > 0 {
+			return response.BadRequest(errors.New("The filter and name options can't be combined"))
 		}
 
-		// Check the requested network type matches the type created when adding the local member config.
-		if req.Type != netInfo.Type {
-			return fmt.Errorf("Requested network type %q doesn't match type in existing database record %q", req.Type, netInfo.Type)
-		}
+		return networksDeleteNames(s, r, projectName, reqProject, names)
 	}
 
-	// Check that the network is properly defined, get the node-specific configs and merge with global config.
-	var nodeConfigs map[string]map[string]string
-	err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
-		// Check if any global config exists already, if so we should not create global config again.
-		if netInfo != nil && networkPartiallyCreated(netInfo) {
-			if len(req.Config) > 0 {
-				return errors.New("Network already partially created. Please do not specify any global config when re-running create")
-			}
+	if clauses == nil || len(clauses.Clauses) == 0 {
+		return response.BadRequest(errors.New("A filter is required for bulk network deletion"))
+	}
 
-			logger.Debug("Skipping global network create as global config already partially created", logger.Ctx{"project": projectName, "network": req.Name})
-			return nil
+	var networkNames []string
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		networkNames, err = tx.GetNetworks(ctx, projectName)
+
+		return err
+	})
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	userHasPermission, err := s.Authorizer.GetPermissionChecker(r.Context(), r, auth.EntitlementCanEdit, auth.ObjectTypeNetwork)
+	if err != nil {
+		return response.InternalError(err)
+	}
+
+	cache, err := loadNetworkListCache(r.Context(), s)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	results := make([]api.NetworksDeleteResult, 0)
+	for _, networkName := range networkNames {
+		// Networks the caller can't see or edit are silently skipped like in listings.
+		if !userHasPermission(auth.ObjectNetwork(projectName, networkName)) {
+			continue
 		}
 
-		// Fetch the network ID.
-		networkID, err := tx.GetNetworkID(ctx, projectName, req.Name)
+		netInfo, err := doNetworkGet(s, r, s.ServerClustered, networkFilterUsesUsedBy(clauses), projectName, reqProject.Config, networkName, cache)
 		if err != nil {
-			return err
+			continue
 		}
 
-		// Fetch the node-specific configs and check the network is defined for all nodes.
-		nodeConfigs, err = tx.NetworkNodeConfigs(ctx, networkID)
+		match, err := filter.Match(networkFilterObject(netInfo), *clauses)
 		if err != nil {
-			return err
+			return response.SmartError(err)
 		}
 
-		// Add default values if we are inserting global config for first time.
-		err = netType.FillConfig(req.Config)
-		if err != nil {
-			return err
+		if !match {
+			continue
 		}
 
-		// Insert the global config keys.
-		err = tx.CreateNetworkConfig(networkID, 0, req.Config)
+		result := api.NetworksDeleteResult{
+			Name:    networkName,
+			Project: projectName,
+		}
+
+		// Prevent the network from being re-created while it's being deleted.
+		unlock, err := networkCreateLock(r.Context(), projectName, networkName)
 		if err != nil {
-			return err
+			return response.SmartError(err)
 		}
 
-		// Assume failure unless we succeed later on.
-		return tx.NetworkErrored(projectName, req.Name)
-	})
-	if err != nil {
-		if response.IsNotFoundError(err) {
-			return errors.New("Network not pending on any node (use --target <node> first)")
+		n, err := network.LoadByName(s, projectName, networkName)
+		if err == nil {
+			err = doNetworkDelete(s, r, n, false)
 		}
 
-		return err
+		unlock()
+
+		if err != nil {
+			result.Error = err.Error()
+		}
+
+		results = append(results, result)
 	}
 
-	// Create notifier for other nodes to create the network.
-	notifier, err := cluster.NewNotifier(s, s.Endpoints.NetworkCert(), s.ServerCert(), cluster.NotifyAll)
-	if err != nil {
-		return err
+	return response.SyncResponse(true, results)
+}
+
+// networksDeleteNames deletes the listed networks once all of them were checked to be deletable.
+// When clustered, each member is notified once for the whole list rather than once per network.
+func networksDeleteNames(s *state.State, r *http.Request, projectName string, reqProject *api.Project, names []string) response.Response {
+	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
+
+	names = slices.Compact(slices.Sorted(slices.Values(names)))
+
+	results := make([]api.NetworksDeleteResult, 0, len(names))
+	for _, networkName := range names {
+		results = append(results, api.NetworksDeleteResult{Name: networkName, Project: projectName})
 	}
 
-	// Load the network from the database for the local member.
-	n, err := network.LoadByName(s, projectName, req.Name)
+	userHasPermission, err := s.Authorizer.GetPermissionChecker(r.Context(), r, auth.EntitlementCanEdit, auth.ObjectTypeNetwork)
 	if err != nil {
-		return fmt.Errorf("Failed loading network: %w", err)
+		return response.InternalError(err)
 	}
 
-	netConfig := n.Config()
+	// Cluster notifications only have the networks deleted locally, the results report the local failures.
+	if isClusterNotification(r) {
+		for i, networkName := range names {
+			if !userHasPermission(auth.ObjectNetwork(projectName, networkName)) {
+				results[i].Error = "Network not found"
+				continue
+			}
 
-	err = doNetworksCreate(ctx, s, n, clientType)
-	if err != nil {
-		return err
-	}
+			n, err := network.LoadByName(s, projectName, networkName)
+			if err == nil && n.LocalStatus() != api.NetworkStatusPending {
+				err = n.Delete(clientType)
+			}
 
-	logger.Debug("Created network on local cluster member", logger.Ctx{"project": projectName, "network": req.Name, "config": netConfig})
+			if err != nil {
+				results[i].Error = err.Error()
+			}
+		}
 
-	// Remove this node's node specific config keys.
-	netConfig = db.StripNodeSpecificNetworkConfig(netConfig)
+		return response.SyncResponse(true, results)
+	}
 
-	// Notify other nodes to create the network.
-	err = notifier(func(client incus.InstanceServer) error {
-		server, _, err := client.GetServer()
+	// Prevent the networks from being re-created while they're being deleted, the sorted names avoid deadlocks.
+	unlocker := revert.New()
+	defer unlocker.Fail()
+
+	for _, networkName := range names {
+		unlock, err := networkCreateLock(r.Context(), projectName, networkName)
 		if err != nil {
-			return err
+			return response.SmartError(err)
 		}
 
-		// Clone the network config for this node so we don't modify it and potentially end up sending
-		// this node's config to another node.
-		nodeConfig := util.CloneMap(netConfig)
+		unlocker.Add(func() { unlock() })
+	}
 
-		// Merge node specific config items into global config.
-		maps.Copy(nodeConfig, nodeConfigs[server.Environment.ServerName])
+	// Check all the networks before deleting any of them.
+	networks := make([]network.Network, 0, len(names))
+	var checkErrs []error
+	for _, networkName := range names {
+		if !userHasPermission(auth.ObjectNetwork(projectName, networkName)) {
+			checkErrs = append(checkErrs, fmt.Errorf("Network %q not found", networkName))
+			continue
+		}
 
-		// Create fresh request based on existing network to send to node.
-		nodeReq := api.NetworksPost{
-			NetworkPut: api.NetworkPut{
-				Config:      nodeConfig,
-				Description: n.Description(),
-			},
-			Name: n.Name(),
-			Type: n.Type(),
+		n, err := network.LoadByName(s, projectName, networkName)
+		if err != nil {
+			checkErrs = append(checkErrs, fmt.Errorf("Failed loading network %q: %w", networkName, err))
+			continue
+		}
+
+		if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+			checkErrs = append(checkErrs, networkNotAllowedError(s, r, networkName))
+			continue
 		}
 
-		err = client.UseProject(n.Project()).CreateNetwork(nodeReq)
+		inUse, err := n.IsUsed(false)
 		if err != nil {
-			return err
+			checkErrs = append(checkErrs, fmt.Errorf("Failed checking whether network %q is in use: %w", networkName, err))
+			continue
 		}
 
-		logger.Debug("Created network on cluster member", logger.Ctx{"project": n.Project(), "network": n.Name(), "member": server.Environment.ServerName, "config": nodeReq.Config})
+		if inUse {
+			checkErrs = append(checkErrs, fmt.Errorf("Network %q is currently in use", networkName))
+			continue
+		}
 
-		return nil
-	})
-	if err != nil {
-		return err
+		networks = append(networks, n)
 	}
 
-	// Mark network global status as networkCreated now that all nodes have succeeded.
-	err = s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
-		return tx.NetworkCreated(projectName, req.Name)
-	})
-	if err != nil {
-		return err
+	if len(checkErrs) > 0 {
+		return response.BadRequest(errors.Join(checkErrs...))
 	}
 
-	logger.Debug("Marked network global status as created", logger.Ctx{"project": projectName, "network": req.Name})
+	// Delete the networks locally.
+	deleted := make([]string, 0, len(networks))
+	for i, n := range networks {
+		if n.LocalStatus() != api.NetworkStatusPending {
+			err := n.Delete(clientType)
+			if err != nil {
+				results[i].Error = err.Error()
+				continue
+			}
+		}
 
-	return nil
-}
+		deleted = append(deleted, n.Name())
+	}
 
-// Create the network on the system. The clusterNotification flag is used to indicate whether creation request
-// is coming from a cluster notification (and if so we should not delete the database record on error).
-func doNetworksCreate(ctx context.Context, s *state.State, n network.Network, clientType clusterRequest.ClientType) error {
-	reverter := revert.New()
-	defer reverter.Fail()
+	// Notify the other cluster members once for all the networks deleted locally.
+	if s.ServerClustered && len(deleted) > 0 {
+		memberResults, memberErrs, err := networkMembersCollect(s, r, func(client incus.InstanceServer) ([]api.NetworksDeleteResult, error) {
+			return client.UseProject(projectName).DeleteNetworks(deleted)
+		})
+		if err != nil {
+			return response.SmartError(err)
+		}
 
-	validateConfig := n.Config()
+		for i := range results {
+			if results[i].Error != "" || !slices.Contains(deleted, results[i].Name) {
+				continue
+			}
 
-	// Skip the ACLs during validation on cluster join as those aren't yet available in the database.
-	if clientType == clusterRequest.ClientTypeJoiner {
-		validateConfig = map[string]string{}
+			var errs []error
+			for _, memberName := range slices.Sorted(maps.Keys(memberErrs)) {
+				errs = append(errs, fmt.Errorf("Cluster member %q: %w", memberName, memberErrs[memberName]))
+			}
 
-		for k, v := range n.Config() {
-			if k == "security.acls" || strings.HasPrefix(k, "security.acls.") {
-				continue
+			for _, memberName := range slices.Sorted(maps.Keys(memberResults)) {
+				for _, memberResult := range memberResults[memberName] {
+					if memberResult.Name == results[i].Name && memberResult.Error != "" {
+						errs = append(errs, fmt.Errorf("Cluster member %q: %s", memberName, memberResult.Error))
+					}
+				}
 			}
 
-			validateConfig[k] = v
+			if len(errs) > 0 {
+				results[i].Error = fmt.Sprintf("Failed deleting network on cluster members: %v", errors.Join(errs...))
+			}
 		}
 	}
 
-	// Validate so that when run on a cluster node the full config (including node specific config) is checked.
-	err := n.Validate(validateConfig)
-	if err != nil {
-		return err
-	}
+	// Remove the records of the networks deleted everywhere.
+	for i, n := range networks {
+		if results[i].Error != "" {
+			continue
+		}
 
-	if n.LocalStatus() == api.NetworkStatusCreated {
-		logger.Debug("Skipping local network create as already created", logger.Ctx{"project": n.Project(), "network": n.Name()})
-		return nil
+		err := networkDeleteRecord(s, r, n)
+		if err != nil {
+			results[i].Error = err.Error()
+		}
 	}
 
-	// Run initial creation setup for the network driver.
-	err = n.Create(clientType)
-	if err != nil {
-		return err
-	}
+	return response.SyncResponse(true, results)
+}
 
-	reverter.Add(func() { _ = n.Delete(clientType) })
+// networkNotAllowedError returns the error to report for a network the requesting project's restrictions
+// don't allow. Callers who can't view the network get the usual not found error to not leak its existence.
+func networkNotAllowedError(s *state.State, r *http.Request, networkName string) error {
+	projectName := request.ProjectParam(r)
 
-	// Only start networks when not doing a cluster pre-join phase (this ensures that networks are only started
-	// once the node has fully joined the clustered database and has consistent config with rest of the nodes).
-	if clientType != clusterRequest.ClientTypeJoiner {
-		err = n.Start()
-		if err != nil {
-			return err
-		}
+	networkProjectName, _, err := project.NetworkProject(s.DB.Cluster, projectName)
+	if err != nil {
+		return api.StatusErrorf(http.StatusNotFound, "Network not found")
 	}
 
-	// Mark local as status as networkCreated.
-	err = s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
-		return tx.NetworkNodeCreated(n.ID())
-	})
+	err = s.Authorizer.CheckPermission(r.Context(), r, auth.ObjectNetwork(networkProjectName, networkName), auth.EntitlementCanView)
 	if err != nil {
-		return err
+		return api.StatusErrorf(http.StatusNotFound, "Network not found")
 	}
 
-	logger.Debug("Marked network local status as created", logger.Ctx{"project": n.Project(), "network": n.Name()})
+	return api.StatusErrorf(http.StatusForbidden, "Network %q isn't allowed by the restrictions of project %q", networkName, projectName)
+}
 
-	reverter.Success()
-	return nil
+// networkCreateLock acquires the lock preventing concurrent creations of a network and returns the unlock function.
+// An empty network name locks network creation across the whole project.
+func networkCreateLock(ctx context.Context, projectName string, networkName string) (locking.UnlockFunc, error) {
+	return locking.Lock(ctx, fmt.Sprintf("NetworkCreate_%s/%s", projectName, networkName))
 }
 
-// swagger:operation GET /1.0/networks/{name} networks network_get
+// swagger:operation POST /1.0/networks networks networks_post
 //
-//	Get the network
+//	Add a network
 //
-//	Gets a specific network.
+//	Creates a new network.
+//	When clustered, most network types require individual POST for each cluster member prior to a global POST.
 //
 //	---
+//	consumes:
+//	  - application/json
 //	produces:
 //	  - application/json
 //	parameters:
@@ -871,9 +1214,53 @@ func doNetworksCreate(ctx context.Context, s *state.State, n network.Network, cl
 //	    description: Cluster member name
 //	    type: string
 //	    example: server01
+//	  - in: query
+//	    name: lint
+//	    description: Return advisory messages about the network config (doesn't block creation)
+//	    type: boolean
+//	    example: true
+//	  - in: query
+//	    name: apply
+//	    description: Converge an existing network to the requested state instead of failing (returns a NetworkApplyResult)
+//	    type: boolean
+//	    example: true
+//	  - in: query
+//	    name: import
+//	    description: Recreate a network along with its forwards and zones from a NetworkExport definition provided as the body
+//	    type: boolean
+//	    example: true
+//	  - in: query
+//	    name: overwrite
+//	    description: When importing, update an existing network instead of failing
+//	    type: boolean
+//	    example: true
+//	  - in: query
+//	    name: validate
+//	    description: Only check whether the network would be accepted, without creating it ("keys" returns a NetworkConfigValidation with the failures of each config key)
+//	    type: string
+//	    example: true
+//	  - in: query
+//	    name: async
+//	    description: Create the network on the cluster members as a background operation (cluster-wide creation only)
+//	    type: boolean
+//	    example: true
+//	  - in: query
+//	    name: allow-subnet-overlap
+//	    description: Allow using subnets overlapping those of another network of the project
+//	    type: boolean
+//	    example: true
+//	  - in: body
+//	    name: network
+//	    description: Network
+//	    required: true
+//	    schema:
+//	      $ref: "#/definitions/NetworksPost"
 //	responses:
 //	  "200":
-//	    description: Network
+//	    description: |-
+//	      The created network with its resolved config (including allocated addresses).
+//	      When lint is set, the list of advisory messages is returned instead.
+//	      Member specific definitions (target) return no metadata.
 //	    schema:
 //	      type: object
 //	      description: Sync response
@@ -892,158 +1279,3364 @@ func doNetworksCreate(ctx context.Context, s *state.State, n network.Network, cl
 //	          example: 200
 //	        metadata:
 //	          $ref: "#/definitions/Network"
+//	  "202":
+//	    $ref: "#/responses/Operation"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
 //	  "403":
 //	    $ref: "#/responses/Forbidden"
 //	  "500":
 //	    $ref: "#/responses/InternalServerError"
-func networkGet(d *Daemon, r *http.Request) response.Response {
+func networksPost(d *Daemon, r *http.Request) response.Response {
 	s := d.State()
 
-	// If a target was specified, forward the request to the relevant node.
-	resp := forwardedResponseIfTargetIsRemote(s, r)
-	if resp != nil {
-		return resp
-	}
-
 	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
 	if err != nil {
 		return response.SmartError(err)
 	}
 
-	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	// Recreate an exported network definition.
+	if util.IsTrue(request.QueryParam(r, "import")) {
+		return networksPostImport(d, r)
+	}
+
+	req := api.NetworksPost{}
+
+	// Parse the request.
+	err = json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
-		return response.SmartError(err)
+		return response.BadRequest(err)
 	}
 
-	allNodes := false
-	if s.ServerClustered && request.QueryParam(r, "target") == "" {
-		allNodes = true
+	// Quick checks.
+	if req.Name == "" {
+		return response.BadRequest(errors.New("No name provided"))
+	}
+
+	// The locks are released on return unless handed over to a background operation.
+	unlocker := revert.New()
+	defer unlocker.Fail()
+
+	// The name and limits checks below depend on the project's other networks, so serialize creation
+	// across the whole project when they apply.
+	if util.IsTrue(reqProject.Config["network.names.case_insensitive"]) || (projectName != api.ProjectDefaultName && reqProject.Config["limits.networks"] != "") {
+		unlock, err := networkCreateLock(r.Context(), projectName, "")
+		if err != nil {
+			return response.SmartError(err)
+		}
+
+		unlocker.Add(func() { unlock() })
 	}
 
-	n, err := doNetworkGet(s, r, allNodes, projectName, reqProject.Config, networkName)
+	// Prevent concurrent creations of the same network, including the pending and cluster finalize steps.
+	unlock, err := networkCreateLock(r.Context(), projectName, req.Name)
 	if err != nil {
 		return response.SmartError(err)
 	}
 
-	etag := []any{n.Name, n.Managed, n.Type, n.Description, n.Config}
+	unlocker.Add(func() { unlock() })
 
-	return response.SyncResponseETag(true, &n, etag)
-}
+	if slices.Contains(networkReservedNames, req.Name) {
+		return response.BadRequest(fmt.Errorf("Network name %q is reserved", req.Name))
+	}
+
+	// Don't let a managed network shadow an unmanaged host interface, unless it's wrapping it as its parent.
+	if projectName == api.ProjectDefaultName && req.Config["parent"] != req.Name {
+		osInfo, _ := net.InterfaceByName(req.Name)
+		if osInfo != nil {
+			err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+				_, _, _, err := tx.GetNetworkInAnyState(ctx, projectName, req.Name)
+
+				return err
+			})
+			if err != nil {
+				if !response.IsNotFoundError(err) {
+					return response.SmartError(err)
+				}
+
+				return response.BadRequest(fmt.Errorf("Network name %q conflicts with an existing host interface", req.Name))
+			}
+		}
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, req.Name, true) {
+		return response.SmartError(api.StatusErrorf(http.StatusForbidden, "Network not allowed in project"))
+	}
+
+	// Fill in the type and config from the source network when copying.
+	if req.Source != "" {
+		err = networkCopySource(s, projectName, &req)
+		if err != nil {
+			return response.SmartError(err)
+		}
+	}
+
+	// When converging to a desired state, an omitted type refers to the existing network's type (if any).
+	if req.Type == "" && util.IsTrue(request.QueryParam(r, "apply")) {
+		err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+			_, netInfo, _, err := tx.GetNetworkInAnyState(ctx, projectName, req.Name)
+			if err != nil {
+				return err
+			}
+
+			req.Type = netInfo.Type
+
+			return nil
+		})
+		if err != nil && !response.IsNotFoundError(err) {
+			return response.SmartError(err)
+		}
+	}
+
+	if req.Type == "" && reqProject.Config["network.default_type"] != "" {
+		// Use the project's default type, as long as it can be used in the project.
+		defaultType := reqProject.Config["network.default_type"]
+		netType, err := network.LoadByType(defaultType)
+		if err != nil {
+			return response.BadRequest(fmt.Errorf("Invalid default network type %q of project %q: %w", defaultType, reqProject.Name, err))
+		}
+
+		if projectName != api.ProjectDefaultName && !netType.Info().Projects {
+			return response.BadRequest(fmt.Errorf("Default network type %q of project %q does not support non-default projects", defaultType, reqProject.Name))
+		}
+
+		req.Type = defaultType
+	} else if req.Type == "" {
+		if projectName != api.ProjectDefaultName {
+			req.Type = "ovn" // Only OVN networks are allowed inside network enabled projects.
+		} else {
+			req.Type = "bridge" // Default to bridge for non-network enabled projects.
+		}
+	}
+
+	if req.Config == nil {
+		req.Config = map[string]string{}
+	}
+
+	// Expand the project variables referenced in the config, the expanded values are the ones stored.
+	if !isClusterNotification(r) {
+		err = networkExpandConfig(r.Context(), s, projectName, req.Config)
+		if err != nil {
+			return response.SmartError(err)
+		}
+	}
+
+	netType, err := network.LoadByType(req.Type)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	err = netType.ValidateName(req.Name)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	netTypeInfo := netType.Info()
+	if projectName != api.ProjectDefaultName && !netTypeInfo.Projects {
+		return response.BadRequest(errors.New("Network type does not support non-default projects"))
+	}
+
+	// Check that no network name only differs by case if the project requires it.
+	if util.IsTrue(reqProject.Config["network.names.case_insensitive"]) {
+		var networks []string
+
+		err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+			networks, err = tx.GetNetworks(ctx, projectName)
+
+			return err
+		})
+		if err != nil {
+			return response.InternalError(fmt.Errorf("Failed loading project's networks for name check: %w", err))
+		}
+
+		for _, existing := range networks {
+			if existing != req.Name && strings.EqualFold(existing, req.Name) {
+				return response.Conflict(fmt.Errorf("Network %q conflicts with existing network %q (names are case insensitive in this project)", req.Name, existing))
+			}
+		}
+	}
+
+	// Check if project has limits.network and if so check we are allowed to create another network.
+	if projectName != api.ProjectDefaultName && reqProject.Config != nil && reqProject.Config["limits.networks"] != "" {
+		networksLimit, err := strconv.Atoi(reqProject.Config["limits.networks"])
+		if err != nil {
+			return response.InternalError(fmt.Errorf("Invalid project limits.network value: %w", err))
+		}
+
+		var networks []string
+
+		err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+			networks, err = tx.GetNetworks(ctx, projectName)
+
+			return err
+		})
+		if err != nil {
+			return response.InternalError(fmt.Errorf("Failed loading project's networks for limits check: %w", err))
+		}
+
+		// Only check network limits if the new network name doesn't exist already in networks list.
+		// If it does then this create request will either be for adding a target node to an existing
+		// pending network or it will fail anyway as it is a duplicate.
+		if !slices.Contains(networks, req.Name) && len(networks) >= networksLimit {
+			return response.BadRequest(errors.New("Networks limit has been reached for project"))
+		}
+	}
+
+	// Only check whether the network would be accepted if requested.
+	validate := request.QueryParam(r, "validate")
+	if util.IsTrue(validate) || validate == "keys" {
+		if util.IsTrue(request.QueryParam(r, "apply")) {
+			return response.BadRequest(errors.New("The validate and apply options can't be combined"))
+		}
+
+		return networksPostValidate(s, r, projectName, netType, req, validate == "keys")
+	}
+
+	// Run the advisory lint pass if requested, its findings are returned but never block creation.
+	var lintMessages []string
+	if util.IsTrue(request.QueryParam(r, "lint")) {
+		lintMessages = network.Lint(netType.Type(), req.Config)
+
+		// Configs copied from a cluster may carry member specific keys, flag that they only apply to this server.
+		if !s.ServerClustered {
+			for _, key := range slices.Sorted(maps.Keys(req.Config)) {
+				if db.IsNodeSpecificNetworkConfig(key) {
+					lintMessages = append(lintMessages, fmt.Sprintf("Config key %q is member specific, it only applies to this standalone server and isn't shared with other members if it joins a cluster", key))
+				}
+			}
+		}
+	}
+
+	u := api.NewURL().Path(version.APIVersion, "networks", req.Name).Project(projectName)
+
+	resp := response.SyncResponseLocation(true, lintMessages, u.String())
+
+	apply := util.IsTrue(request.QueryParam(r, "apply"))
+
+	// createdResponse returns the created network so that auto-allocated config is known to the caller
+	// without a follow-up request, unless lint findings were requested.
+	createdResponse := func() response.Response {
+		if apply {
+			return response.SyncResponseLocation(true, api.NetworkApplyResult{Action: "created", Changed: slices.Sorted(maps.Keys(req.Config))}, u.String())
+		}
+
+		if util.IsTrue(request.QueryParam(r, "lint")) {
+			return resp
+		}
+
+		netInfo, err := doNetworkGet(s, r, s.ServerClustered, true, projectName, reqProject.Config, req.Name, nil)
+		if err != nil {
+			logger.Warn("Failed loading created network", logger.Ctx{"project": projectName, "network": req.Name, "err": err})
+			return resp
+		}
+
+		return response.SyncResponseLocation(true, netInfo, u.String())
+	}
+
+	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
+
+	// Converge an existing network to the requested state rather than failing.
+	if apply {
+		if request.QueryParam(r, "target") != "" {
+			return response.BadRequest(errors.New("The apply and target options can't be combined"))
+		}
+
+		n, err := network.LoadByName(s, projectName, req.Name)
+		if err != nil && !api.StatusErrorCheck(err, http.StatusNotFound) {
+			return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+		}
+
+		if n != nil && n.Status() == api.NetworkStatusCreated {
+			return networksPostApply(s, r, n, netType, req, clientType)
+		}
+	}
+
+	// Refuse subnets overlapping those of the project's other networks unless allowed.
+	if clientType == clusterRequest.ClientTypeNormal && !util.IsTrue(request.QueryParam(r, "allow-subnet-overlap")) {
+		err = networkCheckSubnetOverlap(r.Context(), s, projectName, req.Name, req.Config)
+		if err != nil {
+			return response.SmartError(err)
+		}
+	}
+
+	if isClusterNotification(r) {
+		n, err := network.LoadByName(s, projectName, req.Name)
+		if err != nil {
+			return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+		}
+
+		// This is an internal request which triggers the actual creation of the network across all nodes
+		// after they have been previously defined.
+		err = doNetworksCreate(r.Context(), s, n, clientType)
+		if err != nil {
+			return response.SmartError(err)
+		}
+
+		return resp
+	}
+
+	targetNode := request.QueryParam(r, "target")
+	if targetNode != "" {
+		if util.IsTrue(request.QueryParam(r, "async")) {
+			return response.BadRequest(errors.New("The async and target options can't be combined"))
+		}
+
+		if !netTypeInfo.NodeSpecificConfig {
+			return response.BadRequest(fmt.Errorf("Network type %q does not support member specific config", netType.Type()))
+		}
+
+		// A targetNode was specified, let's just define the node's network without actually creating it.
+		// Check that only NodeSpecificNetworkConfig keys are specified.
+		for key := range req.Config {
+			if !db.IsNodeSpecificNetworkConfig(key) {
+				return response.BadRequest(fmt.Errorf("Config key %q may not be used as member-specific key", key))
+			}
+		}
+
+		exists := false
+		err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+			_, err := tx.GetNetworkID(ctx, projectName, req.Name)
+			if err == nil {
+				exists = true
+			}
+
+			return tx.CreatePendingNetwork(ctx, targetNode, projectName, req.Name, req.Description, netType.DBType(), req.Config)
+		})
+		if err != nil {
+			if errors.Is(err, db.ErrAlreadyDefined) {
+				return response.Conflict(fmt.Errorf("Network %q is already defined on member %q", req.Name, targetNode))
+			}
+
+			return response.SmartError(err)
+		}
+
+		if !exists {
+			err = s.Authorizer.AddNetwork(r.Context(), projectName, req.Name)
+			if err != nil {
+				logger.Error("Failed to add network to authorizer", logger.Ctx{"name": req.Name, "project": projectName, "error": err})
+			}
+
+			n, err := network.LoadByName(s, projectName, req.Name)
+			if err != nil {
+				return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+			}
+
+			requestor := request.CreateRequestor(r)
+			s.Events.SendLifecycle(projectName, lifecycle.NetworkCreated.Event(n, requestor, nil))
+		}
+
+		return resp
+	}
+
+	// Check that the uplink can still provide an address to the new OVN network.
+	if netType.Type() == "ovn" && req.Config["network"] != "" && req.Config["network"] != "none" {
+		uplinkNet, err := network.LoadByName(s, api.ProjectDefaultName, req.Config["network"])
+		if err != nil && !api.StatusErrorCheck(err, http.StatusNotFound) {
+			return response.SmartError(fmt.Errorf("Failed loading uplink network %q: %w", req.Config["network"], err))
+		}
+
+		if uplinkNet != nil {
+			err = network.OVNUplinkCapacityCheck(s, uplinkNet)
+			if err != nil {
+				return response.BadRequest(err)
+			}
+		}
+	}
+
+	var netInfo *api.Network
+
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		// Load existing network if exists, if not don't fail.
+		_, netInfo, _, err = tx.GetNetworkInAnyState(ctx, projectName, req.Name)
+
+		return err
+	})
+	if err != nil && !api.StatusErrorCheck(err, http.StatusNotFound) {
+		return response.InternalError(err)
+	}
+
+	// Check if we're clustered.
+	count, err := cluster.Count(s)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// No targetNode was specified and we're clustered or there is an existing partially created single node
+	// network, either way finalize the config in the db and actually create the network on all cluster nodes.
+	if count > 1 || (netInfo != nil && netInfo.Status != api.NetworkStatusCreated) {
+		// Member specific keys must be defined per member using target, reject them before anything gets
+		// recorded (or started in the background) rather than having them ignored.
+		if clientType == clusterRequest.ClientTypeNormal {
+			for _, key := range slices.Sorted(maps.Keys(req.Config)) {
+				if db.IsNodeSpecificNetworkConfig(key) {
+					return response.BadRequest(fmt.Errorf("Config key %q is cluster member specific, it must be set per member using target", key))
+				}
+			}
+		}
+
+		// Simulate adding pending node network config when the driver doesn't support per-node config.
+		if !netTypeInfo.NodeSpecificConfig && clientType != clusterRequest.ClientTypeJoiner {
+			// Create pending entry for each node.
+			err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+				members, err := tx.GetNodes(ctx)
+				if err != nil {
+					return fmt.Errorf("Failed getting cluster members: %w", err)
+				}
+
+				for _, member := range members {
+					// Don't pass in any config, as these nodes don't have any node-specific
+					// config and we don't want to create duplicate global config.
+					err = tx.CreatePendingNetwork(ctx, member.Name, projectName, req.Name, req.Description, netType.DBType(), nil)
+					if err != nil && !errors.Is(err, db.ErrAlreadyDefined) {
+						return fmt.Errorf("Failed creating pending network for member %q: %w", member.Name, err)
+					}
+				}
+
+				return nil
+			})
+			if err != nil {
+				return response.SmartError(err)
+			}
+
+			// Create the authorization entry and advertise the network as existing.
+			err = s.Authorizer.AddNetwork(r.Context(), projectName, req.Name)
+			if err != nil {
+				logger.Error("Failed to add network to authorizer", logger.Ctx{"name": req.Name, "project": projectName, "error": err})
+			}
+
+			n, err := network.LoadByName(s, projectName, req.Name)
+			if err != nil {
+				return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+			}
+
+			requestor := request.CreateRequestor(r)
+			s.Events.SendLifecycle(projectName, lifecycle.NetworkCreated.Event(n, requestor, nil))
+		}
+
+		// Create the network on all members in the background if requested.
+		if util.IsTrue(request.QueryParam(r, "async")) {
+			// Keep holding the creation locks until the operation is done.
+			unlock := unlocker.Clone()
+			unlocker.Success()
+
+			run := func(op *operations.Operation) error {
+				defer unlock.Fail()
+
+				return networksPostCluster(context.Background(), s, projectName, netInfo, req, clientType, netType, op)
+			}
+
+			opResources := map[string][]api.URL{}
+			opResources["networks"] = []api.URL{*api.NewURL().Path(version.APIVersion, "networks", req.Name).Project(projectName)}
+
+			op, err := operations.OperationCreate(s, projectName, operations.OperationClassTask, operationtype.NetworkCreate, opResources, nil, run, nil, nil, r)
+			if err != nil {
+				unlock.Fail()
+				return response.SmartError(err)
+			}
+
+			return operations.OperationResponse(op)
+		}
+
+		err = networksPostCluster(r.Context(), s, projectName, netInfo, req, clientType, netType, nil)
+		if err != nil {
+			return response.SmartError(err)
+		}
+
+		return createdResponse()
+	}
+
+	// Non-clustered network creation.
+	if util.IsTrue(request.QueryParam(r, "async")) {
+		return response.BadRequest(errors.New("The async option is only supported when creating a network across a cluster"))
+	}
+
+	if netInfo != nil {
+		return response.Conflict(fmt.Errorf("Network %q already exists", req.Name))
+	}
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	// Populate default config.
+	if clientType != clusterRequest.ClientTypeJoiner {
+		err = netType.FillConfig(req.Config)
+		if err != nil {
+			return response.SmartError(err)
+		}
+	}
+
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		// Create the database entry.
+		_, err = tx.CreateNetwork(ctx, projectName, req.Name, req.Description, netType.DBType(), req.Config)
+
+		return err
+	})
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Error inserting %q into database: %w", req.Name, err))
+	}
+
+	reverter.Add(func() {
+		_ = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+			return tx.DeleteNetwork(ctx, projectName, req.Name)
+		})
+	})
+
+	n, err := network.LoadByName(s, projectName, req.Name)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	err = doNetworksCreate(r.Context(), s, n, clientType)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	err = s.Authorizer.AddNetwork(r.Context(), projectName, req.Name)
+	if err != nil {
+		logger.Error("Failed to add network to authorizer", logger.Ctx{"name": req.Name, "project": projectName, "error": err})
+	}
+
+	requestor := request.CreateRequestor(r)
+	s.Events.SendLifecycle(projectName, lifecycle.NetworkCreated.Event(n, requestor, nil))
+
+	reverter.Success()
+	return createdResponse()
+}
+
+// networkReservedNames are the names which can't be used for networks as they have a special meaning for the
+// network devices or the kernel.
+var networkReservedNames = []string{"all", "lo", "none"}
+
+// networkCopyExcludedKeys are the config keys which must be unique to a network or are derived from its subnets
+// and so aren't copied from a source network, letting the new network auto-allocate its own values instead.
+var networkCopyExcludedKeys = []string{
+	"bridge.hwaddr",
+	"ipv4.address",
+	"ipv4.dhcp.gateway",
+	"ipv4.dhcp.ranges",
+	"ipv4.dhcp.reservations",
+	"ipv4.dhcp.routes",
+	"ipv4.nat.address",
+	"ipv4.ovn.ranges",
+	"ipv4.routes",
+	"ipv4.routes.external",
+	"ipv6.address",
+	"ipv6.dhcp.ranges",
+	"ipv6.dhcp.reservations",
+	"ipv6.nat.address",
+	"ipv6.ovn.ranges",
+	"ipv6.routes",
+	"ipv6.routes.external",
+}
+
+// networkCopySource merges the shared config of the request's source network underneath the requested
+// config and sets the request type from the source network if not specified.
+func networkCopySource(s *state.State, projectName string, req *api.NetworksPost) error {
+	if req.Source == req.Name {
+		return api.StatusErrorf(http.StatusBadRequest, "Network can't be copied onto itself")
+	}
+
+	source, err := network.LoadByName(s, projectName, req.Source)
+	if err != nil {
+		return fmt.Errorf("Failed loading source network %q: %w", req.Source, err)
+	}
+
+	if req.Type == "" {
+		req.Type = source.Type()
+	} else if req.Type != source.Type() {
+		return api.StatusErrorf(http.StatusBadRequest, "Can't copy network %q of type %q to a network of type %q", req.Source, source.Type(), req.Type)
+	}
+
+	if req.Description == "" {
+		req.Description = source.Description()
+	}
+
+	config := make(map[string]string, len(source.Config())+len(req.Config))
+	for key, value := range db.StripNodeSpecificNetworkConfig(source.Config()) {
+		if strings.HasPrefix(key, "volatile.") || slices.Contains(networkCopyExcludedKeys, key) {
+			continue
+		}
+
+		config[key] = value
+	}
+
+	// User provided config takes precedence over the copied config.
+	maps.Copy(config, req.Config)
+	req.Config = config
+
+	// The request now stands on its own, don't have cluster members copy the source again.
+	req.Source = ""
+
+	return nil
+}
+
+// networksPostValidate checks whether the network creation request would be accepted, without creating anything.
+func networksPostValidate(s *state.State, r *http.Request, projectName string, netType network.Type, req api.NetworksPost, perKey bool) response.Response {
+	netTypeInfo := netType.Info()
+
+	targetNode := request.QueryParam(r, "target")
+	if targetNode != "" {
+		if !netTypeInfo.NodeSpecificConfig {
+			return response.BadRequest(fmt.Errorf("Network type %q does not support member specific config", netType.Type()))
+		}
+
+		for key := range req.Config {
+			if !db.IsNodeSpecificNetworkConfig(key) {
+				return response.BadRequest(fmt.Errorf("Config key %q may not be used as member-specific key", key))
+			}
+		}
+
+		// The rest of the config is only known once the network gets created, so validate the member
+		// specific values on their own.
+		config := localUtil.CopyConfig(req.Config)
+
+		err := netType.FillConfig(config)
+		if err != nil {
+			return response.SmartError(err)
+		}
+
+		return networksPostValidateConfigs(s, projectName, req, map[string]map[string]string{targetNode: config}, map[string]string{}, perKey)
+	}
+
+	var netInfo *api.Network
+
+	err := s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+
+		_, netInfo, _, err = tx.GetNetworkInAnyState(ctx, projectName, req.Name)
+
+		return err
+	})
+	if err != nil && !api.StatusErrorCheck(err, http.StatusNotFound) {
+		return response.InternalError(err)
+	}
+
+	if netInfo != nil && netInfo.Status == api.NetworkStatusCreated {
+		return response.Conflict(fmt.Errorf("Network %q already exists", req.Name))
+	}
+
+	count, err := cluster.Count(s)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	config := localUtil.CopyConfig(req.Config)
+	keyErrs := map[string]string{}
+
+	// Refuse subnets overlapping those of the project's other networks unless allowed, as the creation would.
+	if !util.IsTrue(request.QueryParam(r, "allow-subnet-overlap")) {
+		overlapErrs, err := networkSubnetOverlaps(r.Context(), s, projectName, req.Name, config)
+		if err != nil {
+			return response.SmartError(err)
+		}
+
+		for _, key := range networkSubnetKeys {
+			if overlapErrs[key] == nil {
+				continue
+			}
+
+			if !perKey {
+				return response.SmartError(overlapErrs[key])
+			}
+
+			keyErrs[key] = overlapErrs[key].Error()
+		}
+	}
+
+	// Apply the same preconditions as the creation across the cluster.
+	var nodeConfigs map[string]map[string]string
+	if count > 1 || (netInfo != nil && netInfo.Status != api.NetworkStatusCreated) {
+		for key := range config {
+			if db.IsNodeSpecificNetworkConfig(key) {
+				if !perKey {
+					return response.BadRequest(fmt.Errorf("Config key %q is cluster member specific, it must be set per member using target", key))
+				}
+
+				keyErrs[key] = fmt.Sprintf("Config key %q is cluster member specific, it must be set per member using target", key)
+				delete(config, key)
+			}
+		}
+
+		if netInfo != nil {
+			if req.Type != netInfo.Type {
+				return response.BadRequest(fmt.Errorf("Requested network type %q doesn't match type in existing database record %q", req.Type, netInfo.Type))
+			}
+
+			if networkPartiallyCreated(netInfo) && len(req.Config) > 0 {
+				return response.BadRequest(errors.New("Network already partially created. Please do not specify any global config when re-running create"))
+			}
+		}
+
+		// Drivers with member specific config must first be defined on every member.
+		if netTypeInfo.NodeSpecificConfig {
+			if netInfo == nil {
+				return response.BadRequest(errors.New("Network not pending on any node (use --target <node> first)"))
+			}
+
+			err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+				networkID, err := tx.GetNetworkID(ctx, projectName, req.Name)
+				if err != nil {
+					return err
+				}
+
+				nodeConfigs, err = tx.NetworkNodeConfigs(ctx, networkID)
+
+				return err
+			})
+			if err != nil {
+				return response.BadRequest(err)
+			}
+		}
+	}
+
+	err = netType.FillConfig(config)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Validate the config each member would end up with.
+	configs := map[string]map[string]string{"": config}
+	if nodeConfigs != nil {
+		configs = map[string]map[string]string{}
+		for memberName, nodeConfig := range nodeConfigs {
+			memberConfig := localUtil.CopyConfig(config)
+			maps.Copy(memberConfig, nodeConfig)
+			configs[memberName] = memberConfig
+		}
+	}
+
+	return networksPostValidateConfigs(s, projectName, req, configs, keyErrs, perKey)
+}
+
+// networksPostValidateConfigs validates the config of the new network for each of the members (indexed by
+// member name, or by an empty name for a standalone server), on top of the already known key failures.
+func networksPostValidateConfigs(s *state.State, projectName string, req api.NetworksPost, configs map[string]map[string]string, keyErrs map[string]string, perKey bool) response.Response {
+	result := api.NetworkConfigValidation{Errors: keyErrs}
+
+	for _, memberName := range slices.Sorted(maps.Keys(configs)) {
+		config := configs[memberName]
+
+		n, err := network.LoadTransient(s, projectName, &api.Network{Name: req.Name, Description: req.Description, Type: req.Type, Config: config})
+		if err != nil {
+			return response.SmartError(err)
+		}
+
+		if !perKey {
+			err = n.Validate(config)
+			if err != nil {
+				if memberName != "" {
+					return response.BadRequest(fmt.Errorf("Invalid config on member %q: %w", memberName, err))
+				}
+
+				return response.BadRequest(err)
+			}
+
+			continue
+		}
+
+		memberResult := networkValidateConfig(n, config, map[string]string{})
+		for key, msg := range memberResult.Errors {
+			_, found := result.Errors[key]
+			if found {
+				continue
+			}
+
+			if memberName != "" {
+				msg = fmt.Sprintf("%s (on member %q)", msg, memberName)
+			}
+
+			result.Errors[key] = msg
+		}
+
+		if result.Error == "" && memberResult.Error != "" {
+			result.Error = memberResult.Error
+			if memberName != "" {
+				result.Error = fmt.Sprintf("%s (on member %q)", result.Error, memberName)
+			}
+		}
+	}
+
+	if !perKey {
+		return response.EmptySyncResponse
+	}
+
+	result.Valid = len(result.Errors) == 0 && result.Error == ""
+
+	return response.SyncResponse(true, result)
+}
+
+// networkValidateConfig validates the config against the network, reporting the failures of each key on top of
+// the already known ones rather than only the first failure.
+func networkValidateConfig(n network.Network, config map[string]string, keyErrs map[string]string) api.NetworkConfigValidation {
+	result := api.NetworkConfigValidation{Errors: keyErrs}
+
+	err := n.Validate(config)
+	if err != nil {
+		var configKeyErrs network.ConfigKeyErrors
+		var configKeyErr network.ConfigKeyError
+		if errors.As(err, &configKeyErrs) {
+			for _, keyErr := range configKeyErrs {
+				result.Errors[keyErr.Key] = keyErr.Error()
+			}
+		} else if errors.As(err, &configKeyErr) {
+			result.Errors[configKeyErr.Key] = configKeyErr.Error()
+		} else {
+			result.Error = err.Error()
+		}
+	}
+
+	result.Valid = len(result.Errors) == 0 && result.Error == ""
+
+	return result
+}
+
+// networksPostApply updates an existing network to match the requested description and config.
+// Config keys that are auto-generated on creation keep their current value when not explicitly requested, so
+// that applying the same request again is a no-op.
+func networksPostApply(s *state.State, r *http.Request, n network.Network, netType network.Type, req api.NetworksPost, clientType clusterRequest.ClientType) response.Response {
+	err := s.Authorizer.CheckPermission(r.Context(), r, auth.ObjectNetwork(n.Project(), n.Name()), auth.EntitlementCanEdit)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	if n.Type() != netType.Type() {
+		return response.BadRequest(fmt.Errorf("Network %q already exists with type %q", n.Name(), n.Type()))
+	}
+
+	curConfig := n.Config()
+
+	// Keep the current value of any key that would be auto-generated.
+	desiredConfig := localUtil.CopyConfig(req.Config)
+	filledConfig := localUtil.CopyConfig(req.Config)
+	err = netType.FillConfig(filledConfig)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	for k, v := range filledConfig {
+		if req.Config[k] == v {
+			continue
+		}
+
+		curValue, ok := curConfig[k]
+		if ok {
+			desiredConfig[k] = curValue
+		} else {
+			desiredConfig[k] = v
+		}
+	}
+
+	// Work out what differs from the current state.
+	newConfig := networkUpdateConfig(n, localUtil.CopyConfig(desiredConfig), "", http.MethodPut, s.ServerClustered)
+	changed := networkConfigChangedKeys(curConfig, newConfig)
+
+	if req.Description != n.Description() {
+		changed = append([]string{"description"}, changed...)
+	}
+
+	u := api.NewURL().Path(version.APIVersion, "networks", n.Name()).Project(n.Project())
+
+	if len(changed) == 0 {
+		return response.SyncResponseLocation(true, api.NetworkApplyResult{Action: "unchanged", Changed: changed}, u.String())
+	}
+
+	for _, k := range changed {
+		if s.ServerClustered && db.IsNodeSpecificNetworkConfig(k) {
+			return response.BadRequest(fmt.Errorf("Config key %q is cluster member specific", k))
+		}
+	}
+
+	err = doNetworkUpdate(s, n, api.NetworkPut{Description: req.Description, Config: desiredConfig}, "", clientType, http.MethodPut, s.ServerClustered, false, util.IsTrue(request.QueryParam(r, "allow-subnet-overlap")))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	requestor := request.CreateRequestor(r)
+	s.Events.SendLifecycle(n.Project(), lifecycle.NetworkUpdated.Event(n, requestor, nil))
+
+	return response.SyncResponseLocation(true, api.NetworkApplyResult{Action: "updated", Changed: changed}, u.String())
+}
+
+// networkPartiallyCreated returns true of supplied network has properties that indicate it has had previous
+// create attempts run on it but failed on one or more nodes.
+func networkPartiallyCreated(netInfo *api.Network) bool {
+	// If the network status is NetworkStatusErrored, this means create has been run in the past and has
+	// failed on one or more nodes. Hence it is partially created.
+	if netInfo.Status == api.NetworkStatusErrored {
+		return true
+	}
+
+	// If the network has global config keys, then it has previously been created by having its global config
+	// inserted, and this means it is partialled created.
+	for key := range netInfo.Config {
+		if !db.IsNodeSpecificNetworkConfig(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// networkCreateMaxBackoff is the longest wait between two attempts at creating a network on a cluster member.
+const networkCreateMaxBackoff = 30 * time.Second
+
+// networksPostCluster checks that there is a pending network in the database and then attempts to setup the
+// network on each node. If all nodes are successfully setup then the network's state is set to created.
+// Accepts an optional existing network record, which will exist when performing subsequent re-create attempts.
+func networksPostCluster(ctx context.Context, s *state.State, projectName string, netInfo *api.Network, req api.NetworksPost, clientType clusterRequest.ClientType, netType network.Type, op *operations.Operation) error {
+	// Check that no node-specific config key has been supplied in request.
+	for key := range req.Config {
+		if db.IsNodeSpecificNetworkConfig(key) {
+			return fmt.Errorf("Config key %q is cluster member specific", key)
+		}
+	}
+
+	// If network already exists, perform quick checks.
+	if netInfo != nil {
+		// Check network isn't already created.
+		if netInfo.Status == api.NetworkStatusCreated {
+			return errors.New("The network is already created")
+		}
+
+		// Check the requested network type matches the type created when adding the local member config.
+		if req.Type != netInfo.Type {
+			return fmt.Errorf("Requested network type %q doesn't match type in existing database record %q", req.Type, netInfo.Type)
+		}
+	}
+
+	// The network the errored events refer to, as it may not be loadable from the database on failure.
+	eventNet, err := network.LoadTransient(s, projectName, &api.Network{Name: req.Name, Type: req.Type})
+	if err != nil {
+		return err
+	}
+
+	// Check that the network is properly defined, get the node-specific configs and merge with global config.
+	var nodeConfigs map[string]map[string]string
+	err = s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		// Check if any global config exists already, if so we should not create global config again.
+		if netInfo != nil && networkPartiallyCreated(netInfo) {
+			if len(req.Config) > 0 {
+				return errors.New("Network already partially created. Please do not specify any global config when re-running create")
+			}
+
+			logger.Debug("Skipping global network create as global config already partially created", logger.Ctx{"project": projectName, "network": req.Name})
+			return nil
+		}
+
+		// Fetch the network ID.
+		networkID, err := tx.GetNetworkID(ctx, projectName, req.Name)
+		if err != nil {
+			return err
+		}
+
+		// Fetch the node-specific configs and check the network is defined for all nodes.
+		nodeConfigs, err = tx.NetworkNodeConfigs(ctx, networkID)
+		if err != nil {
+			return err
+		}
+
+		// Add default values if we are inserting global config for first time.
+		err = netType.FillConfig(req.Config)
+		if err != nil {
+			return err
+		}
+
+		// Insert the global config keys.
+		err = tx.CreateNetworkConfig(networkID, 0, req.Config)
+		if err != nil {
+			return err
+		}
+
+		// Assume failure unless we succeed later on.
+		return tx.NetworkErrored(projectName, req.Name)
+	})
+	if err != nil {
+		if response.IsNotFoundError(err) {
+			return errors.New("Network not pending on any node (use --target <node> first)")
+		}
+
+		return err
+	}
+
+	// Let watchers know when the network is left in errored state along with which members failed.
+	var progressMu sync.Mutex
+	memberErrs := map[string]string{}
+	reportErrored := func(err error) {
+		progressMu.Lock()
+		defer progressMu.Unlock()
+
+		eventCtx := map[string]any{"error": err.Error()}
+		if len(memberErrs) > 0 {
+			eventCtx["members"] = memberErrs
+		}
+
+		s.Events.SendLifecycle(eventNet.Project(), lifecycle.NetworkErrored.Event(eventNet, nil, eventCtx))
+	}
+
+	// Create notifier for other nodes to create the network.
+	notifier, err := cluster.NewNotifier(s, s.Endpoints.NetworkCert(), s.ServerCert(), cluster.NotifyAll)
+	if err != nil {
+		reportErrored(err)
+
+		return err
+	}
+
+	// Load the network from the database for the local member.
+	n, err := network.LoadByName(s, projectName, req.Name)
+	if err != nil {
+		err = fmt.Errorf("Failed loading network: %w", err)
+		reportErrored(err)
+
+		return err
+	}
+
+	netConfig := n.Config()
+
+	// Report the members the network got created on when running as an operation.
+	var membersCreated []string
+	var membersTotal int
+	if op != nil {
+		membersTotal, err = cluster.Count(s)
+		if err != nil {
+			reportErrored(err)
+
+			return err
+		}
+	}
+
+	reportProgress := func(memberName string) {
+		if op == nil {
+			return
+		}
+
+		progressMu.Lock()
+		defer progressMu.Unlock()
+
+		membersCreated = append(membersCreated, memberName)
+
+		metadata := map[string]any{
+			"create_progress": fmt.Sprintf("Created on %d/%d cluster members", len(membersCreated), membersTotal),
+			"members_created": slices.Clone(membersCreated),
+		}
+
+		_ = op.UpdateMetadata(metadata)
+	}
+
+	err = doNetworksCreate(ctx, s, n, clientType)
+	if err != nil {
+		memberErrs[s.ServerName] = err.Error()
+		reportErrored(err)
+
+		return err
+	}
+
+	logger.Debug("Created network on local cluster member", logger.Ctx{"project": projectName, "network": req.Name, "config": netConfig})
+	reportProgress(s.ServerName)
+
+	// Remove this node's node specific config keys.
+	netConfig = db.StripNodeSpecificNetworkConfig(netConfig)
+
+	// Notify other nodes to create the network, each of them gets a deadline so that an unresponsive member
+	// doesn't hold the create lock forever.
+	err = notifier(networkMemberNotify(s, ctx, func(memberCtx context.Context, client incus.InstanceServer) error {
+		server, _, err := client.GetServer()
+		if err != nil {
+			return err
+		}
+
+		// Clone the network config for this node so we don't modify it and potentially end up sending
+		// this node's config to another node.
+		nodeConfig := util.CloneMap(netConfig)
+
+		// Merge node specific config items into global config.
+		maps.Copy(nodeConfig, nodeConfigs[server.Environment.ServerName])
+
+		// Create fresh request based on existing network to send to node.
+		nodeReq := api.NetworksPost{
+			NetworkPut: api.NetworkPut{
+				Config:      nodeConfig,
+				Description: n.Description(),
+			},
+			Name: n.Name(),
+			Type: n.Type(),
+		}
+
+		// Retry the member with exponential backoff to ride out transient failures.
+		retries := s.GlobalConfig.NetworkCreateRetries()
+		for attempt := int64(0); ; attempt++ {
+			err = client.UseProject(n.Project()).CreateNetwork(nodeReq)
+			if err == nil {
+				break
+			}
+
+			// Client errors won't go away by retrying.
+			statusCode, _ := api.StatusErrorMatch(err)
+			if attempt >= retries || (statusCode >= http.StatusBadRequest && statusCode < http.StatusInternalServerError) {
+				progressMu.Lock()
+				memberErrs[server.Environment.ServerName] = err.Error()
+				progressMu.Unlock()
+
+				return err
+			}
+
+			backoff := min(time.Second<<attempt, networkCreateMaxBackoff)
+			logger.Debug("Failed creating network on cluster member, retrying", logger.Ctx{"project": n.Project(), "network": n.Name(), "member": server.Environment.ServerName, "attempt": attempt + 1, "backoff": backoff, "err": err})
+
+			select {
+			case <-time.After(backoff):
+			case <-memberCtx.Done():
+				return fmt.Errorf("Failed creating network: %w", memberCtx.Err())
+			}
+		}
+
+		logger.Debug("Created network on cluster member", logger.Ctx{"project": n.Project(), "network": n.Name(), "member": server.Environment.ServerName, "config": nodeReq.Config})
+		reportProgress(server.Environment.ServerName)
+
+		return nil
+	}))
+	if err != nil {
+		reportErrored(err)
+
+		return err
+	}
+
+	// Mark network global status as networkCreated now that all nodes have succeeded.
+	err = s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		return tx.NetworkCreated(projectName, req.Name)
+	})
+	if err != nil {
+		reportErrored(err)
+
+		return err
+	}
+
+	logger.Debug("Marked network global status as created", logger.Ctx{"project": projectName, "network": req.Name})
+
+	return nil
+}
+
+// Create the network on the system. The clusterNotification flag is used to indicate whether creation request
+// is coming from a cluster notification (and if so we should not delete the database record on error).
+func doNetworksCreate(ctx context.Context, s *state.State, n network.Network, clientType clusterRequest.ClientType) error {
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	validateConfig := n.Config()
+
+	// Skip the ACLs during validation on cluster join as those aren't yet available in the database.
+	if clientType == clusterRequest.ClientTypeJoiner {
+		validateConfig = map[string]string{}
+
+		for k, v := range n.Config() {
+			if k == "security.acls" || strings.HasPrefix(k, "security.acls.") {
+				continue
+			}
+
+			validateConfig[k] = v
+		}
+	}
+
+	// Validate so that when run on a cluster node the full config (including node specific config) is checked.
+	err := n.Validate(validateConfig)
+	if err != nil {
+		return err
+	}
+
+	if n.LocalStatus() == api.NetworkStatusCreated {
+		logger.Debug("Skipping local network create as already created", logger.Ctx{"project": n.Project(), "network": n.Name()})
+		return nil
+	}
+
+	// Run initial creation setup for the network driver.
+	err = n.Create(clientType)
+	if err != nil {
+		return err
+	}
+
+	reverter.Add(func() { _ = n.Delete(clientType) })
+
+	// Only start networks when not doing a cluster pre-join phase (this ensures that networks are only started
+	// once the node has fully joined the clustered database and has consistent config with rest of the nodes).
+	if clientType != clusterRequest.ClientTypeJoiner {
+		err = n.Start()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Mark local as status as networkCreated.
+	err = s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		return tx.NetworkNodeCreated(n.ID())
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Marked network local status as created", logger.Ctx{"project": n.Project(), "network": n.Name()})
+
+	reverter.Success()
+	return nil
+}
+
+// swagger:operation GET /1.0/networks/{name} networks network_get
+//
+//	Get the network
+//
+//	Gets a specific network.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: query
+//	    name: target
+//	    description: Cluster member name
+//	    type: string
+//	    example: server01
+//	  - in: query
+//	    name: render
+//	    description: Include the driver artifacts rendered from the current config (server administrators only)
+//	    type: boolean
+//	    example: true
+//	  - in: query
+//	    name: export
+//	    description: Return a portable definition of the network and its ACLs, forwards and zones (see NetworkExport)
+//	    type: boolean
+//	    example: true
+//	responses:
+//	  "200":
+//	    description: Network
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          $ref: "#/definitions/Network"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	// If a target was specified, forward the request to the relevant node.
+	resp := forwardedResponseIfTargetIsRemote(s, r)
+	if resp != nil {
+		return resp
+	}
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	allNodes := false
+	if s.ServerClustered && request.QueryParam(r, "target") == "" {
+		allNodes = true
+	}
+
+	n, err := doNetworkGet(s, r, allNodes, true, projectName, reqProject.Config, networkName, nil)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	if util.IsTrue(request.QueryParam(r, "export")) {
+		if !n.Managed {
+			return response.BadRequest(errors.New("Only managed networks can be exported"))
+		}
+
+		export, err := networkExport(s, r, projectName, n)
+		if err != nil {
+			return response.SmartError(err)
+		}
+
+		return response.SyncResponse(true, export)
+	}
+
+	if util.IsTrue(request.QueryParam(r, "render")) {
+		// Rendered artifacts include host paths and raw driver config.
+		err = s.Authorizer.CheckPermission(r.Context(), r, auth.ObjectServer(), auth.EntitlementCanEdit)
+		if err != nil {
+			return response.SmartError(err)
+		}
+
+		if !n.Managed {
+			return response.BadRequest(errors.New("Only managed networks can be rendered"))
+		}
+
+		netInfo, err := network.LoadByName(s, projectName, networkName)
+		if err != nil {
+			return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+		}
+
+		n.Rendered, err = netInfo.Render()
+		if err != nil {
+			return response.SmartError(fmt.Errorf("Failed rendering network: %w", err))
+		}
+	}
+
+	etag := []any{n.Name, n.Managed, n.Type, n.Description, n.Config}
+
+	return response.SyncResponseETag(true, &n, etag)
+}
+
+// networkListCache holds the data shared by all the networks of a listing.
+type networkListCache struct {
+	scheduledChanges map[int64]db.NetworkScheduledChange
+	projects         []api.Project
+	references       *network.References
+}
+
+// loadNetworkListCache loads the data shared by all the networks of a listing.
+func loadNetworkListCache(ctx context.Context, s *state.State) (*networkListCache, error) {
+	cache := &networkListCache{}
+
+	err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+
+		cache.scheduledChanges, err = tx.GetNetworkScheduledChanges(ctx)
+		if err != nil {
+			return fmt.Errorf("Failed loading network scheduled changes: %w", err)
+		}
+
+		cache.projects, err = project.LoadProjectsWithConfig(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		cache.references, err = network.LoadReferences(ctx, tx, nil)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cache, nil
+}
+
+// doNetworkGet returns information about the specified network.
+// If the network being requested is a managed network and allNodes is true then node specific config is removed.
+// Otherwise if allNodes is false then the network's local status is returned.
+// The resources using the network are only looked up when withUsedBy is true as this requires scanning all projects.
+// The cache is used when listing networks so that shared data is loaded once rather than for each network, it can
+// be nil otherwise.
+func doNetworkGet(s *state.State, r *http.Request, allNodes bool, withUsedBy bool, projectName string, reqProjectConfig map[string]string, networkName string, cache *networkListCache) (api.Network, error) {
+	// Get some information.
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil && !api.StatusErrorCheck(err, http.StatusNotFound) {
+		return api.Network{}, fmt.Errorf("Failed loading network: %w", err)
+	}
+
+	// Ignore unmanaged veth pairs (for performance reasons).
+	if n == nil && strings.HasPrefix(networkName, "veth") {
+		return api.Network{}, api.StatusErrorf(http.StatusNotFound, "Network not found")
+	}
+
+	// Don't allow retrieving info about the local server interfaces when not using default project.
+	if projectName != api.ProjectDefaultName && n == nil {
+		return api.Network{}, api.StatusErrorf(http.StatusNotFound, "Network not found")
+	}
+
+	osInfo, _ := net.InterfaceByName(networkName)
+
+	// Quick check, done first so that restrictions aren't reported for networks which don't exist.
+	if osInfo == nil && n == nil {
+		return api.Network{}, api.StatusErrorf(http.StatusNotFound, "Network not found")
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProjectConfig, networkName, n != nil && n.IsManaged()) {
+		return api.Network{}, networkNotAllowedError(s, r, networkName)
+	}
+
+	// Prepare the response.
+	apiNet := api.Network{}
+	apiNet.Name = networkName
+	apiNet.UsedBy = []string{}
+	apiNet.Config = map[string]string{}
+	apiNet.Project = projectName
+	apiNet.ProjectDefault = reqProjectConfig["network.default"] == networkName
+
+	// Set the device type as needed.
+	if n != nil {
+		apiNet.Managed = true
+		apiNet.Description = n.Description()
+		apiNet.Type = n.Type()
+		apiNet.CreatedAt = n.CreatedAt()
+		apiNet.UUID = n.UUID()
+		apiNet.AddressFamilies = networkAddressFamilies(n.Config())
+
+		err = s.Authorizer.CheckPermission(r.Context(), r, auth.ObjectNetwork(projectName, networkName), auth.EntitlementCanEdit)
+		if err == nil {
+			// Only allow admins to see network config as sensitive info can be stored there.
+			apiNet.Config = n.Config()
+
+			// Same goes for any pending scheduled config change.
+			if cache != nil {
+				change, ok := cache.scheduledChanges[n.ID()]
+				if ok {
+					apiNet.ScheduledChange = change.ToAPI()
+				}
+			} else {
+				var change *db.NetworkScheduledChange
+				err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+					change, err = tx.GetNetworkScheduledChange(ctx, n.ID())
+
+					return err
+				})
+				if err == nil {
+					apiNet.ScheduledChange = change.ToAPI()
+				} else if !api.StatusErrorCheck(err, http.StatusNotFound) {
+					return api.Network{}, err
+				}
+			}
+
+			// List the projects which can consume the network so its exposure can be audited.
+			var projects []api.Project
+			if cache != nil {
+				projects = cache.projects
+			} else {
+				err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+					projects, err = project.LoadProjectsWithConfig(ctx, tx)
+
+					return err
+				})
+				if err != nil {
+					return api.Network{}, err
+				}
+			}
+
+			apiNet.AllowedProjects = project.NetworkAllowedProjects(projects, n.Project(), n.Name(), true)
+		} else if !api.StatusErrorCheck(err, http.StatusForbidden) {
+			return api.Network{}, err
+		}
+
+		// If no member is specified, we omit the node-specific fields.
+		if allNodes {
+			apiNet.Config = db.StripNodeSpecificNetworkConfig(apiNet.Config)
+		}
+	} else if osInfo != nil && int(osInfo.Flags&net.FlagLoopback) > 0 {
+		apiNet.Type = "loopback"
+	} else if util.PathExists(fmt.Sprintf("/sys/class/net/%s/bridge", apiNet.Name)) {
+		apiNet.Type = "bridge"
+	} else if util.PathExists(fmt.Sprintf("/proc/net/vlan/%s", apiNet.Name)) {
+		apiNet.Type = "vlan"
+	} else if util.PathExists(fmt.Sprintf("/sys/class/net/%s/device", apiNet.Name)) {
+		apiNet.Type = "physical"
+	} else if util.PathExists(fmt.Sprintf("/sys/class/net/%s/bonding", apiNet.Name)) {
+		apiNet.Type = "bond"
+	} else {
+		vswitch, err := s.OVS()
+		if err != nil {
+			return api.Network{}, fmt.Errorf("Failed to connect to OVS: %w", err)
+		}
+
+		_, err = vswitch.GetBridge(context.TODO(), apiNet.Name)
+		if err == nil {
+			apiNet.Type = "bridge"
+		} else {
+			apiNet.Type = "unknown"
+		}
+	}
+
+	// Look for instances using the interface.
+	if withUsedBy && apiNet.Type != "loopback" {
+		var networkID int64
+		if n != nil {
+			networkID = n.ID()
+		}
+
+		usedBy, err := network.UsedBy(s, projectName, networkID, apiNet.Name, apiNet.Type, false)
+		if err != nil {
+			return api.Network{}, err
+		}
+
+		apiNet.UsedBy = project.FilterUsedBy(s.Authorizer, r, usedBy)
+
+		// Also list the ACLs, forwards and load balancers tied to the network.
+		if n != nil && n.IsManaged() {
+			var referencedBy []string
+			if cache != nil {
+				referencedBy = cache.references.URLs(n.Project(), n.Name(), n.ID(), n.Config())
+			} else {
+				referencedBy, err = network.ReferencedBy(s, n)
+				if err != nil {
+					return api.Network{}, err
+				}
+			}
+
+			apiNet.ReferencedBy = project.FilterUsedBy(s.Authorizer, r, referencedBy)
+		}
+	}
+
+	if n != nil {
+		if allNodes {
+			apiNet.Status = n.Status()
+		} else {
+			apiNet.Status = n.LocalStatus()
+		}
+
+		apiNet.Locations = n.Locations()
+		apiNet.RestartKeys = n.RestartKeys()
+
+		// Only report the state on each member in the cluster wide view.
+		if allNodes && s.ServerClustered {
+			apiNet.LocationStatus = n.LocationStatus()
+		}
+	}
+
+	apiNet.Annotations = networkAnnotations(apiNet.Config)
+
+	return apiNet, nil
+}
+
+// networkAnnotationPrefix is the config key prefix used to attach a comment to another config key.
+const networkAnnotationPrefix = "user.comment."
+
+// networkAnnotations returns the comments attached to the config keys, keyed by the annotated key.
+func networkAnnotations(config map[string]string) map[string]string {
+	var annotations map[string]string
+	for k, v := range config {
+		annotatedKey, ok := strings.CutPrefix(k, networkAnnotationPrefix)
+		if !ok || annotatedKey == "" {
+			continue
+		}
+
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+
+		annotations[annotatedKey] = v
+	}
+
+	return annotations
+}
+
+// swagger:operation DELETE /1.0/networks/{name} networks network_delete
+//
+//	Delete the network
+//
+//	Removes the network.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: query
+//	    name: force
+//	    description: Remove a network that failed to be created even if cleaning it up fails
+//	    type: boolean
+//	    example: true
+//	  - in: query
+//	    name: check
+//	    description: Only check whether the network can be deleted, without deleting it (returns a NetworkDeleteCheck listing what uses the network)
+//	    type: boolean
+//	    example: true
+//	responses:
+//	  "200":
+//	    $ref: "#/responses/EmptySyncResponse"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkDelete(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Get the existing network.
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
+	}
+
+	// Only report what would prevent the deletion if requested.
+	if util.IsTrue(request.QueryParam(r, "check")) {
+		usedBy, err := network.UsedBy(s, n.Project(), n.ID(), n.Name(), n.Type(), false)
+		if err != nil {
+			return response.SmartError(err)
+		}
+
+		return response.SyncResponse(true, api.NetworkDeleteCheck{
+			Deletable: len(usedBy) == 0,
+			UsedBy:    project.FilterUsedBy(s.Authorizer, r, usedBy),
+		})
+	}
+
+	force := util.IsTrue(request.QueryParam(r, "force"))
+
+	// Cluster members are told to force the deletion of their local state whatever its status.
+	if force && !isClusterNotification(r) && n.Status() == api.NetworkStatusCreated && n.LocalStatus() == api.NetworkStatusCreated {
+		return response.BadRequest(errors.New("Only networks which failed to be created can be forcefully deleted"))
+	}
+
+	err = doNetworkDelete(s, r, n, force)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	return response.EmptySyncResponse
+}
+
+// doNetworkDelete removes the network from this member and, unless handling a cluster notification, from the
+// other cluster members, the database and the authorizer.
+// When force is set, failures to clean up the network are only logged so that a broken network can be removed.
+func doNetworkDelete(s *state.State, r *http.Request, n network.Network, force bool) error {
+	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
+	l := logger.AddContext(logger.Ctx{"project": n.Project(), "network": n.Name()})
+
+	clusterNotification := isClusterNotification(r)
+	if !clusterNotification && !force {
+		// Quick checks.
+		inUse, err := n.IsUsed(false)
+		if err != nil {
+			return err
+		}
+
+		if inUse {
+			return api.StatusErrorf(http.StatusBadRequest, "The network is currently in use")
+		}
+	}
+
+	if n.LocalStatus() != api.NetworkStatusPending {
+		err := n.Delete(clientType)
+		if err != nil {
+			if !force {
+				return err
+			}
+
+			l.Warn("Failed deleting network, removing it anyway", logger.Ctx{"err": err})
+		}
+	}
+
+	// If this is a cluster notification, we're done, any database work will be done by the node that is
+	// originally serving the request.
+	if clusterNotification {
+		return nil
+	}
+
+	// If we are clustered, also notify all other nodes, if any.
+	// The database record is only removed once the network got deleted on all of them.
+	if s.ServerClustered {
+		_, memberErrs, err := networkMembersCollect(s, r, func(client incus.InstanceServer) (any, error) {
+			if force {
+				return nil, client.UseProject(n.Project()).DeleteNetworkForce(n.Name())
+			}
+
+			return nil, client.UseProject(n.Project()).DeleteNetwork(n.Name())
+		})
+		if err != nil {
+			return err
+		}
+
+		err = networkMembersError(memberErrs)
+		if err != nil {
+			if !force {
+				return fmt.Errorf("Failed deleting network on cluster members: %w", err)
+			}
+
+			l.Warn("Failed deleting network on cluster members, removing it anyway", logger.Ctx{"err": err})
+		}
+	}
+
+	return networkDeleteRecord(s, r, n)
+}
+
+// networkDeleteRecord removes the network from the database and the authorizer once it got deleted on all the
+// cluster members.
+func networkDeleteRecord(s *state.State, r *http.Request, n network.Network) error {
+	err := s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		// Remove the network from the database.
+		return tx.DeleteNetwork(ctx, n.Project(), n.Name())
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.Authorizer.DeleteNetwork(r.Context(), n.Project(), n.Name())
+	if err != nil {
+		logger.Error("Failed to remove network from authorizer", logger.Ctx{"name": n.Name(), "project": n.Project(), "error": err})
+	}
+
+	requestor := request.CreateRequestor(r)
+	s.Events.SendLifecycle(n.Project(), lifecycle.NetworkDeleted.Event(n, requestor, nil))
+
+	return nil
+}
+
+// swagger:operation POST /1.0/networks/{name} networks network_post
+//
+//	Rename the network
+//
+//	Renames an existing network.
+//
+//	---
+//	consumes:
+//	  - application/json
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: body
+//	    name: network
+//	    description: Network rename request
+//	    required: true
+//	    schema:
+//	      $ref: "#/definitions/NetworkPost"
+//	responses:
+//	  "200":
+//	    $ref: "#/responses/EmptySyncResponse"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkPost(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	// FIXME: renaming a network is currently not supported in clustering
+	//        mode. The difficulty is that network.Start() depends on the
+	//        network having already been renamed in the database, which is
+	//        a chicken-and-egg problem for cluster notifications (the
+	//        serving node should typically do the database job, so the
+	//        network is not yet renamed in the db when the notified node
+	//        runs network.Start).
+	if s.ServerClustered {
+		return response.BadRequest(errors.New("Renaming clustered network not supported"))
+	}
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	req := api.NetworkPost{}
+
+	// Parse the request.
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	// Get the existing network.
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
+	}
+
+	if n.Status() != api.NetworkStatusCreated {
+		return response.BadRequest(errors.New("Cannot rename network when not in created state"))
+	}
+
+	// Ensure new name is supplied.
+	if req.Name == "" {
+		return response.BadRequest(errors.New("New network name not provided"))
+	}
+
+	err = n.ValidateName(req.Name)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	// Check network isn't in use.
+	inUse, err := n.IsUsed(false)
+	if err != nil {
+		return response.InternalError(fmt.Errorf("Failed checking network in use: %w", err))
+	}
+
+	if inUse {
+		return response.BadRequest(errors.New("Network is currently in use"))
+	}
+
+	var networks []string
+
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		// Check that the name isn't already in used by an existing managed network.
+		networks, err = tx.GetNetworks(ctx, projectName)
+
+		return err
+	})
+	if err != nil {
+		return response.InternalError(err)
+	}
+
+	if slices.Contains(networks, req.Name) {
+		return response.Conflict(fmt.Errorf("Network %q already exists", req.Name))
+	}
+
+	// Check that no other network name only differs by case if the project requires it.
+	if util.IsTrue(reqProject.Config["network.names.case_insensitive"]) {
+		for _, existing := range networks {
+			if existing != networkName && strings.EqualFold(existing, req.Name) {
+				return response.Conflict(fmt.Errorf("Network %q conflicts with existing network %q (names are case insensitive in this project)", req.Name, existing))
+			}
+		}
+	}
+
+	// Rename it.
+	err = n.Rename(req.Name)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	err = s.Authorizer.RenameNetwork(r.Context(), projectName, networkName, req.Name)
+	if err != nil {
+		logger.Error("Failed to rename network in authorizer", logger.Ctx{"old_name": networkName, "new_name": req.Name, "project": projectName, "error": err})
+	}
+
+	requestor := request.CreateRequestor(r)
+	lc := lifecycle.NetworkRenamed.Event(n, requestor, map[string]any{"old_name": networkName})
+	s.Events.SendLifecycle(projectName, lc)
+
+	return response.SyncResponseLocation(true, nil, lc.Source)
+}
+
+// swagger:operation PUT /1.0/networks/{name} networks network_put
+//
+//	Update the network
+//
+//	Updates the entire network configuration.
+//
+//	---
+//	consumes:
+//	  - application/json
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: query
+//	    name: target
+//	    description: Cluster member name
+//	    type: string
+//	    example: server01
+//	  - in: query
+//	    name: apply-at
+//	    description: Schedule the change to be applied at the given time (RFC3339) instead of immediately
+//	    type: string
+//	    example: 2025-02-18T22:00:00Z
+//	  - in: query
+//	    name: force
+//	    description: Allow removing all addressing from a network in use by instances
+//	    type: boolean
+//	    example: true
+//	  - in: query
+//	    name: allow-subnet-overlap
+//	    description: Allow using subnets overlapping those of another network of the project
+//	    type: boolean
+//	    example: true
+//	  - in: query
+//	    name: preview
+//	    description: Only return the resulting config and the keys it changes, without applying it
+//	    type: boolean
+//	    example: true
+//	  - in: body
+//	    name: network
+//	    description: Network configuration
+//	    required: true
+//	    schema:
+//	      $ref: "#/definitions/NetworkPut"
+//	responses:
+//	  "200":
+//	    $ref: "#/responses/EmptySyncResponse"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "412":
+//	    $ref: "#/responses/PreconditionFailed"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkPut(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	// If a target was specified, forward the request to the relevant node.
+	resp := forwardedResponseIfTargetIsRemote(s, r)
+	if resp != nil {
+		return resp
+	}
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Get the existing network.
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
+	}
+
+	targetNode := request.QueryParam(r, "target")
+
+	if targetNode == "" && n.Status() != api.NetworkStatusCreated {
+		return response.BadRequest(errors.New("Cannot update network global config when not in created state"))
+	}
+
+	// Duplicate config for etag modification and generation.
+	etagConfig := localUtil.CopyConfig(n.Config())
+
+	// If no target node is specified and the daemon is clustered, we omit the node-specific fields so that
+	// the e-tag can be generated correctly. This is because the GET request used to populate the request
+	// will also remove node-specific keys when no target is specified.
+	if targetNode == "" && s.ServerClustered {
+		etagConfig = db.StripNodeSpecificNetworkConfig(etagConfig)
+	}
+
+	// Validate the ETag.
+	etag := []any{n.Name(), n.IsManaged(), n.Type(), n.Description(), etagConfig}
+	err = localUtil.EtagCheck(r, etag)
+	if err != nil {
+		return response.PreconditionFailed(err)
+	}
+
+	// Decode the request.
+	req := api.NetworkPut{}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	// In clustered mode, we differentiate between node specific and non-node specific config keys based on
+	// whether the user has specified a target to apply the config to.
+	if s.ServerClustered {
+		curConfig := n.Config()
+		changedConfig := make(map[string]string, len(req.Config))
+		for key, value := range req.Config {
+			if curConfig[key] == value {
+				continue
+			}
+
+			changedConfig[key] = value
+		}
+
+		if targetNode == "" {
+			// If no target is specified, then ensure only non-node-specific config keys are changed.
+			for k := range changedConfig {
+				if db.IsNodeSpecificNetworkConfig(k) {
+					return response.BadRequest(fmt.Errorf("Config key %q is cluster member specific", k))
+				}
+			}
+		} else {
+			// If a target is specified, then ensure only node-specific config keys are changed.
+			for k := range changedConfig {
+				if !db.IsNodeSpecificNetworkConfig(k) {
+					return response.BadRequest(fmt.Errorf("Config key %q may not be used as member-specific key", k))
+				}
+			}
+		}
+	}
+
+	// Only report what would change if requested.
+	if util.IsTrue(request.QueryParam(r, "preview")) {
+		if request.QueryParam(r, "apply-at") != "" {
+			return response.BadRequest(errors.New("The preview and apply-at options can't be combined"))
+		}
+
+		return networkUpdatePreview(s, n, req, targetNode, r.Method, s.ServerClustered)
+	}
+
+	// Store the change for later if asked to apply it at a given time.
+	applyAtStr := request.QueryParam(r, "apply-at")
+	if applyAtStr != "" {
+		return networkScheduleUpdate(s, r, n, req, targetNode, applyAtStr)
+	}
+
+	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
+
+	force := util.IsTrue(request.QueryParam(r, "force"))
+	allowSubnetOverlap := util.IsTrue(request.QueryParam(r, "allow-subnet-overlap"))
+
+	err = doNetworkUpdate(s, n, req, targetNode, clientType, r.Method, s.ServerClustered, force, allowSubnetOverlap)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	requestor := request.CreateRequestor(r)
+	s.Events.SendLifecycle(projectName, lifecycle.NetworkUpdated.Event(n, requestor, nil))
+
+	return response.EmptySyncResponse
+}
+
+// swagger:operation PATCH /1.0/networks/{name} networks network_patch
+//
+//	Partially update the network
+//
+//	Updates a subset of the network configuration.
+//
+//	---
+//	consumes:
+//	  - application/json
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: query
+//	    name: target
+//	    description: Cluster member name
+//	    type: string
+//	    example: server01
+//	  - in: query
+//	    name: apply-at
+//	    description: Schedule the change to be applied at the given time (RFC3339) instead of immediately
+//	    type: string
+//	    example: 2025-02-18T22:00:00Z
+//	  - in: query
+//	    name: force
+//	    description: Allow removing all addressing from a network in use by instances
+//	    type: boolean
+//	    example: true
+//	  - in: query
+//	    name: allow-subnet-overlap
+//	    description: Allow using subnets overlapping those of another network of the project
+//	    type: boolean
+//	    example: true
+//	  - in: query
+//	    name: preview
+//	    description: Only return the resulting config and the keys it changes, without applying it
+//	    type: boolean
+//	    example: true
+//	  - in: body
+//	    name: network
+//	    description: Network configuration
+//	    required: true
+//	    schema:
+//	      $ref: "#/definitions/NetworkPut"
+//	responses:
+//	  "200":
+//	    $ref: "#/responses/EmptySyncResponse"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "412":
+//	    $ref: "#/responses/PreconditionFailed"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkPatch(d *Daemon, r *http.Request) response.Response {
+	return networkPut(d, r)
+}
+
+// doNetworkUpdate loads the current local network config, merges with the requested network config, validates
+// and applies the changes. Will also notify other cluster nodes of non-node specific config if needed.
+func doNetworkUpdate(s *state.State, n network.Network, req api.NetworkPut, targetNode string, clientType clusterRequest.ClientType, httpMethod string, clustered bool, force bool, allowSubnetOverlap bool) error {
+	req.Config = networkUpdateConfig(n, req.Config, targetNode, httpMethod, clustered)
+
+	// Expand the project variables referenced in the config, the expanded values are the ones stored.
+	if clientType == clusterRequest.ClientTypeNormal {
+		err := networkExpandConfig(context.TODO(), s, n.Project(), req.Config)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Only the description changed, update it without having the driver reconfigure the network.
+	if clientType == clusterRequest.ClientTypeNormal && req.Description != n.Description() && maps.Equal(req.Config, n.Config()) {
+		err := s.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+			return tx.UpdateNetworkDescription(ctx, n.Project(), n.Name(), req.Description)
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	// Validate the merged configuration.
+	err := n.Validate(req.Config)
+	if err != nil {
+		return api.StatusErrorf(http.StatusBadRequest, "%v", err)
+	}
+
+	// Prevent accidentally removing all addressing from a network that instances rely on.
+	if !force && clientType == clusterRequest.ClientTypeNormal && (n.Type() == "bridge" || n.Type() == "ovn") {
+		hadAddress := !util.IsNoneOrEmpty(n.Config()["ipv4.address"]) || !util.IsNoneOrEmpty(n.Config()["ipv6.address"])
+		hasAddress := !util.IsNoneOrEmpty(req.Config["ipv4.address"]) || !util.IsNoneOrEmpty(req.Config["ipv6.address"])
+
+		if hadAddress && !hasAddress {
+			isUsed, err := n.IsUsed(true)
+			if err != nil {
+				return err
+			}
+
+			if isUsed {
+				return api.StatusErrorf(http.StatusBadRequest, "Cannot remove both IPv4 and IPv6 addresses from a network in use by instances (use force to override)")
+			}
+		}
+	}
+
+	// Refuse changing the subnets to ones overlapping those of the project's other networks unless allowed.
+	subnetsChanged := slices.ContainsFunc(networkSubnetKeys, func(key string) bool { return req.Config[key] != n.Config()[key] })
+	if clientType == clusterRequest.ClientTypeNormal && !allowSubnetOverlap && subnetsChanged {
+		err = networkCheckSubnetOverlap(context.TODO(), s, n.Project(), n.Name(), req.Config)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Check that the new uplink can provide an address to the OVN network being moved onto it.
+	if clientType == clusterRequest.ClientTypeNormal && n.Type() == "ovn" && req.Config["network"] != n.Config()["network"] && !util.IsNoneOrEmpty(req.Config["network"]) {
+		uplinkNet, err := network.LoadByName(s, api.ProjectDefaultName, req.Config["network"])
+		if err != nil {
+			return fmt.Errorf("Failed loading uplink network %q: %w", req.Config["network"], err)
+		}
+
+		err = network.OVNUplinkCapacityCheck(s, uplinkNet)
+		if err != nil {
+			return api.StatusErrorf(http.StatusBadRequest, "%v", err)
+		}
+	}
+
+	// Apply the new configuration (will also notify other cluster nodes if needed).
+	err = n.Update(req, targetNode, clientType)
+	if err != nil {
+		return err
+	}
+
+	// Retry starting a network which previously failed to start locally, as the new config may have fixed it.
+	if n.LocalStatus() == api.NetworkStatusUnavailable {
+		err = n.Start()
+		if err != nil {
+			logger.Warn("Failed starting network after update", logger.Ctx{"project": n.Project(), "network": n.Name(), "err": err})
+
+			return nil
+		}
+	}
+
+	_ = warnings.ResolveWarningsByLocalNodeAndProjectAndTypeAndEntity(s.DB.Cluster, n.Project(), warningtype.NetworkUnvailable, dbCluster.TypeNetwork, int(n.ID()))
+
+	return nil
+}
+
+// networkConfigChangedKeys returns the sorted list of keys that are added, changed or removed between both configs.
+func networkConfigChangedKeys(oldConfig map[string]string, newConfig map[string]string) []string {
+	changed := []string{}
+	for k := range newConfig {
+		oldValue, ok := oldConfig[k]
+		if !ok || oldValue != newConfig[k] {
+			changed = append(changed, k)
+		}
+	}
+
+	for k := range oldConfig {
+		_, ok := newConfig[k]
+		if !ok {
+			changed = append(changed, k)
+		}
+	}
+
+	slices.Sort(changed)
+
+	return changed
+}
+
+// networkUpdatePreview returns the config that would result from the update along with the keys it changes,
+// without applying it.
+func networkUpdatePreview(s *state.State, n network.Network, req api.NetworkPut, targetNode string, httpMethod string, clustered bool) response.Response {
+	config := networkUpdateConfig(n, req.Config, targetNode, httpMethod, clustered)
+
+	// Expand the project variables referenced in the config, like when applying the update.
+	err := networkExpandConfig(context.TODO(), s, n.Project(), config)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Validate the merged configuration.
+	err = n.Validate(config)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	curConfig := n.Config()
+
+	// Only show the member specific keys when targeting a member, like when getting the network.
+	if targetNode == "" && clustered {
+		curConfig = db.StripNodeSpecificNetworkConfig(curConfig)
+		config = db.StripNodeSpecificNetworkConfig(config)
+	}
+
+	preview := api.NetworkUpdatePreview{
+		Config:      config,
+		ChangedKeys: networkConfigChangedKeys(curConfig, config),
+		RestartKeys: []string{},
+	}
+
+	for _, k := range preview.ChangedKeys {
+		if slices.Contains(n.RestartKeys(), k) {
+			preview.RestartKeys = append(preview.RestartKeys, k)
+		}
+	}
+
+	return response.SyncResponse(true, preview)
+}
+
+// networkUpdateConfig returns the full config resulting from applying the requested config to the network.
+func networkUpdateConfig(n network.Network, config map[string]string, targetNode string, httpMethod string, clustered bool) map[string]string {
+	if config == nil {
+		config = map[string]string{}
+	}
+
+	// Normally a "put" request will replace all existing config, however when clustered, we need to account
+	// for the node specific config keys and not replace them when the request doesn't specify a specific node.
+	if targetNode == "" && httpMethod != http.MethodPatch && clustered {
+		// If non-node specific config being updated via "put" method in cluster, then merge the current
+		// node-specific network config with the submitted config to allow validation.
+		// This allows removal of non-node specific keys when they are absent from request config.
+		for k, v := range n.Config() {
+			if db.IsNodeSpecificNetworkConfig(k) {
+				config[k] = v
+			}
+		}
+	} else if httpMethod == http.MethodPatch {
+		// If config being updated via "patch" method, then merge all existing config with the keys that
+		// are present in the request config.
+		for k, v := range n.Config() {
+			_, ok := config[k]
+			if !ok {
+				config[k] = v
+			}
+		}
+	}
+
+	// On PATCH, comments on config keys are kept by the merge above like any other key and are removed by
+	// setting them to an empty value. A PUT replaces them along with the rest of the config.
+	if httpMethod == http.MethodPatch {
+		for k, v := range config {
+			if strings.HasPrefix(k, networkAnnotationPrefix) && v == "" {
+				delete(config, k)
+			}
+		}
+	}
+
+	return config
+}
+
+// swagger:operation GET /1.0/networks/{name}/leases networks networks_leases_get
+//
+//	Get the DHCP leases
+//
+//	Returns a list of DHCP leases for the network.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: query
+//	    name: target
+//	    description: Cluster member name
+//	    type: string
+//	    example: server01
+//	  - in: query
+//	    name: filter
+//	    description: Collection filter
+//	    type: string
+//	    example: hostname eq c1
+//	responses:
+//	  "200":
+//	    description: API endpoints
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          type: array
+//	          description: List of DHCP leases
+//	          items:
+//	            $ref: "#/definitions/NetworkLease"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkLeasesGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Parse filter value.
+	clauses, err := filter.Parse(r.FormValue("filter"), filter.QueryOperatorSet())
+	if err != nil {
+		return response.BadRequest(fmt.Errorf("Invalid filter: %w", err))
+	}
+
+	// Attempt to load the network.
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
+	}
+
+	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
+	leases, err := n.Leases(reqProject.Name, clientType)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Leases from other members are merged in by the member serving the request, so only tag them there.
+	if clientType == clusterRequest.ClientTypeNormal {
+		err = networkLeasesTagInstances(s, n, reqProject.Name, leases)
+		if err != nil {
+			return response.SmartError(err)
+		}
+	}
+
+	if clauses != nil && len(clauses.Clauses) > 0 {
+		filtered := make([]api.NetworkLease, 0, len(leases))
+		for _, lease := range leases {
+			match, err := filter.Match(lease, *clauses)
+			if err != nil {
+				return response.SmartError(err)
+			}
+
+			if match {
+				filtered = append(filtered, lease)
+			}
+		}
+
+		leases = filtered
+	}
+
+	return response.SyncResponse(true, leases)
+}
+
+// swagger:operation POST /1.0/networks/{name}/leases networks networks_leases_post
+//
+//	Import DHCP leases
+//
+//	Imports the leases of an existing dnsmasq leases file into the network so that the devices keep their
+//	addresses, for example when migrating a bridge to be managed.
+//	Leases outside of the network's DHCP subnets or conflicting with an existing lease are skipped.
+//	The leases are local to the cluster member handling the request.
+//
+//	---
+//	consumes:
+//	  - application/json
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: query
+//	    name: target
+//	    description: Cluster member name
+//	    type: string
+//	    example: server01
+//	  - in: body
+//	    name: leases
+//	    description: Leases to import
+//	    required: true
+//	    schema:
+//	      $ref: "#/definitions/NetworkLeasesImport"
+//	responses:
+//	  "200":
+//	    description: Import result
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          $ref: "#/definitions/NetworkLeasesImportResult"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "404":
+//	    $ref: "#/responses/NotFound"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkLeasesPost(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	// If a target was specified, forward the request to the relevant node.
+	resp := forwardedResponseIfTargetIsRemote(s, r)
+	if resp != nil {
+		return resp
+	}
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
+	}
+
+	req := api.NetworkLeasesImport{}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	imported, err := n.ImportLeases(req.Leases)
+	if err != nil {
+		if errors.Is(err, network.ErrNotImplemented) {
+			return response.BadRequest(fmt.Errorf("Importing leases isn't supported for %q networks", n.Type()))
+		}
+
+		return response.SmartError(err)
+	}
+
+	return response.SyncResponse(true, api.NetworkLeasesImportResult{Imported: imported})
+}
+
+// swagger:operation POST /1.0/networks/{name}/reservations networks networks_reservations_post
+//
+//	Add a DHCP reservation
+//
+//	Adds a static DHCP reservation to the network's `ipv4.dhcp.reservations` and `ipv6.dhcp.reservations`
+//	configuration keys, the change being applied to all cluster members.
+//
+//	---
+//	consumes:
+//	  - application/json
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: body
+//	    name: reservation
+//	    description: Reservation to add
+//	    required: true
+//	    schema:
+//	      $ref: "#/definitions/NetworkLeaseReservation"
+//	responses:
+//	  "200":
+//	    $ref: "#/responses/EmptySyncResponse"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "404":
+//	    $ref: "#/responses/NotFound"
+//	  "409":
+//	    $ref: "#/responses/Conflict"
+//	  "412":
+//	    $ref: "#/responses/PreconditionFailed"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkReservationsPost(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	req := api.NetworkLeaseReservation{}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	// Serialize the reservation changes so that concurrent requests don't overwrite each other's.
+	unlock, err := locking.Lock(r.Context(), fmt.Sprintf("NetworkReservations_%s/%s", projectName, networkName))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	defer unlock()
+
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
+	}
+
+	if n.Type() != "bridge" {
+		return response.BadRequest(fmt.Errorf("DHCP reservations aren't supported for %q networks", n.Type()))
+	}
+
+	if n.Status() != api.NetworkStatusCreated {
+		return response.BadRequest(errors.New("Cannot update network global config when not in created state"))
+	}
+
+	// Validate the ETag, the same as the one of the network's global config.
+	etagConfig := localUtil.CopyConfig(n.Config())
+	if s.ServerClustered {
+		etagConfig = db.StripNodeSpecificNetworkConfig(etagConfig)
+	}
+
+	err = localUtil.EtagCheck(r, []any{n.Name(), n.IsManaged(), n.Type(), n.Description(), etagConfig})
+	if err != nil {
+		return response.PreconditionFailed(err)
+	}
+
+	hwaddr, err := net.ParseMAC(req.Hwaddr)
+	if err != nil {
+		return response.BadRequest(fmt.Errorf("Invalid MAC address %q: %w", req.Hwaddr, err))
+	}
+
+	if req.IPv4Address == "" && req.IPv6Address == "" {
+		return response.BadRequest(errors.New("An IPv4 or IPv6 address must be provided"))
+	}
+
+	addresses := map[int]net.IP{}
+	for family, address := range map[int]string{4: req.IPv4Address, 6: req.IPv6Address} {
+		if address == "" {
+			continue
+		}
+
+		ip := net.ParseIP(address)
+		if ip == nil {
+			return response.BadRequest(fmt.Errorf("Invalid IP address %q", address))
+		}
+
+		addresses[family] = ip
+	}
+
+	// Check for conflicts with the static addresses of the instance NICs connected to the network.
+	err = networkReservationCheckInstances(s, n, hwaddr, addresses)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	config := map[string]string{}
+	for family, ip := range addresses {
+		key := fmt.Sprintf("ipv%d.dhcp.reservations", family)
+		entries := util.SplitNTrimSpace(n.Config()[key], ",", -1, true)
+
+		// Check for conflicts with the existing reservations.
+		for _, entry := range entries {
+			fields := strings.Fields(entry)
+			if len(fields) < 2 {
+				continue
+			}
+
+			existingHwaddr, _ := net.ParseMAC(fields[0])
+			if existingHwaddr.String() == hwaddr.String() {
+				return response.Conflict(fmt.Errorf("A reservation already exists in %q for %q", key, hwaddr.String()))
+			}
+
+			if net.ParseIP(fields[1]).Equal(ip) {
+				return response.Conflict(fmt.Errorf("The address %q is already reserved in %q", ip.String(), key))
+			}
+		}
+
+		entry := strings.TrimSpace(fmt.Sprintf("%s %s %s", hwaddr.String(), ip.String(), req.Hostname))
+		config[key] = strings.Join(append(entries, entry), ",")
+	}
+
+	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
+
+	err = doNetworkUpdate(s, n, api.NetworkPut{Description: n.Description(), Config: config}, "", clientType, http.MethodPatch, s.ServerClustered, false, false)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	requestor := request.CreateRequestor(r)
+	s.Events.SendLifecycle(projectName, lifecycle.NetworkUpdated.Event(n, requestor, nil))
+
+	return response.EmptySyncResponse
+}
+
+// networkReservationCheckInstances checks that a reservation of the addresses (indexed by IP family) for the MAC
+// address doesn't conflict with the static addresses of the instance NICs connected to the network.
+func networkReservationCheckInstances(s *state.State, n network.Network, hwaddr net.HardwareAddr, addresses map[int]net.IP) error {
+	return network.UsedByInstanceDevices(s, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
+		nicHwaddr := nicConfig["hwaddr"]
+		if nicHwaddr == "" {
+			nicHwaddr = inst.Config[fmt.Sprintf("volatile.%s.hwaddr", nicName)]
+		}
+
+		mac, _ := net.ParseMAC(nicHwaddr)
+		sameMAC := mac != nil && mac.String() == hwaddr.String()
+
+		for family, ip := range addresses {
+			nicAddress := net.ParseIP(nicConfig[fmt.Sprintf("ipv%d.address", family)])
+			if nicAddress == nil {
+				continue
+			}
+
+			if nicAddress.Equal(ip) && !sameMAC {
+				return api.StatusErrorf(http.StatusConflict, "The address %q is statically assigned to NIC %q of instance %q in project %q", ip.String(), nicName, inst.Name, inst.Project)
+			}
+
+			if sameMAC && !nicAddress.Equal(ip) {
+				return api.StatusErrorf(http.StatusConflict, "The MAC address %q belongs to NIC %q of instance %q in project %q which has the static address %q", hwaddr.String(), nicName, inst.Name, inst.Project, nicAddress.String())
+			}
+		}
+
+		return nil
+	})
+}
+
+// swagger:operation DELETE /1.0/networks/{name}/leases/{address} networks network_lease_delete
+//
+//	Delete a DHCP lease
+//
+//	Removes the dynamic DHCP lease of the address so that it can be handed out again.
+//	The leases are local to the cluster member handling the request.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: query
+//	    name: target
+//	    description: Cluster member name
+//	    type: string
+//	    example: server01
+//	responses:
+//	  "200":
+//	    $ref: "#/responses/EmptySyncResponse"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "404":
+//	    $ref: "#/responses/NotFound"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkLeaseDelete(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	// If a target was specified, forward the request to the relevant node.
+	resp := forwardedResponseIfTargetIsRemote(s, r)
+	if resp != nil {
+		return resp
+	}
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	address, err := url.PathUnescape(mux.Vars(r)["address"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
+	}
+
+	err = n.DeleteLease(address)
+	if err != nil {
+		if errors.Is(err, network.ErrNotImplemented) {
+			return response.BadRequest(fmt.Errorf("Deleting leases isn't supported for %q networks", n.Type()))
+		}
+
+		return response.SmartError(err)
+	}
+
+	return response.EmptySyncResponse
+}
+
+// networkLeasesTagInstances fills in the instance and project of the leases whose MAC address belongs to a NIC of
+// an instance of the given project connected to the network.
+func networkLeasesTagInstances(s *state.State, n network.Network, projectName string, leases []api.NetworkLease) error {
+	type leaseInstance struct {
+		project string
+		name    string
+	}
+
+	instances := map[string]leaseInstance{}
+	instFilter := dbCluster.InstanceFilter{Project: &projectName}
+	err := network.UsedByInstanceDevices(s, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
+		hwaddr := nicConfig["hwaddr"]
+		if hwaddr == "" {
+			hwaddr = inst.Config[fmt.Sprintf("volatile.%s.hwaddr", nicName)]
+		}
+
+		mac, err := net.ParseMAC(hwaddr)
+		if err == nil {
+			instances[mac.String()] = leaseInstance{project: inst.Project, name: inst.Name}
+		}
+
+		return nil
+	}, instFilter)
+	if err != nil {
+		return fmt.Errorf("Failed getting instances using the network: %w", err)
+	}
+
+	for i, lease := range leases {
+		mac, err := net.ParseMAC(lease.Hwaddr)
+		if err != nil {
+			continue
+		}
+
+		inst, ok := instances[mac.String()]
+		if !ok {
+			continue
+		}
+
+		leases[i].Instance = inst.name
+		leases[i].Project = inst.project
+	}
+
+	return nil
+}
+
+// networkStartupErrorLogAttempts is the number of failed attempts at initializing a network which are logged as
+// errors, further failures are only logged at debug level to avoid flooding the log.
+const networkStartupErrorLogAttempts = 3
+
+func networkStartup(s *state.State) error {
+	var err error
+
+	// Get a list of projects.
+	var projectNames []string
+
+	err = s.DB.Cluster.Transaction(s.ShutdownCtx, func(ctx context.Context, tx *db.ClusterTx) error {
+		projectNames, err = dbCluster.GetProjectNames(ctx, tx.Tx())
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to load projects: %w", err)
+	}
 
-// doNetworkGet returns information about the specified network.
-// If the network being requested is a managed network and allNodes is true then node specific config is removed.
-// Otherwise if allNodes is false then the network's local status is returned.
-func doNetworkGet(s *state.State, r *http.Request, allNodes bool, projectName string, reqProjectConfig map[string]string, networkName string) (api.Network, error) {
-	// Ignore veth pairs (for performance reasons).
-	if strings.HasPrefix(networkName, "veth") {
-		return api.Network{}, api.StatusErrorf(http.StatusNotFound, "Network not found")
+	// Build a list of networks to initialize, keyed by project and network name.
+	initNetworks := make(map[network.ProjectNetwork]struct{})
+
+	err = s.DB.Cluster.Transaction(s.ShutdownCtx, func(ctx context.Context, tx *db.ClusterTx) error {
+		for _, projectName := range projectNames {
+			networkNames, err := tx.GetCreatedNetworkNamesByProject(ctx, projectName)
+			if err != nil {
+				return fmt.Errorf("Failed to load networks for project %q: %w", projectName, err)
+			}
+
+			for _, networkName := range networkNames {
+				pn := network.ProjectNetwork{
+					ProjectName: projectName,
+					NetworkName: networkName,
+				}
+
+				initNetworks[pn] = struct{}{}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	// Get some information.
-	n, err := network.LoadByName(s, projectName, networkName)
-	if err != nil && !api.StatusErrorCheck(err, http.StatusNotFound) {
-		return api.Network{}, fmt.Errorf("Failed loading network: %w", err)
+	loadedNetworks := make(map[network.ProjectNetwork]network.Network)
+
+	// Load the networks up front to get their configured startup priority and dependencies.
+	startupPriorities := make(map[network.ProjectNetwork]int64)
+	dependencies := make(map[network.ProjectNetwork][]network.ProjectNetwork)
+	for pn := range initNetworks {
+		n, err := network.LoadByName(s, pn.ProjectName, pn.NetworkName)
+		if err != nil {
+			continue // Loading is retried and reported when initializing the network.
+		}
+
+		loadedNetworks[pn] = n
+
+		// Invalid values are reported when the network config is validated.
+		startupPriorities[pn], _ = strconv.ParseInt(n.Config()["startup.priority"], 10, 64)
+		dependencies[pn] = networkStartupDependencies(n.Project(), n.Name(), n.Config())
 	}
 
-	// Don't allow retrieving info about the local server interfaces when not using default project.
-	if projectName != api.ProjectDefaultName && n == nil {
-		return api.Network{}, api.StatusErrorf(http.StatusNotFound, "Network not found")
+	// sortedNetworks returns the networks left to initialize in startup order, reporting dependency cycles once.
+	cyclesReported := false
+	sortedNetworks := func() ([]network.ProjectNetwork, map[network.ProjectNetwork]bool) {
+		sorted, cycles := networkStartupOrder(initNetworks, startupPriorities, dependencies)
+
+		if len(cycles) > 0 && !cyclesReported {
+			names := make([]string, 0, len(cycles))
+			for pn := range cycles {
+				names = append(names, pn.ProjectName+"/"+pn.NetworkName)
+			}
+
+			slices.Sort(names)
+			logger.Warn("Network dependency cycle detected, starting the affected networks regardless of their dependencies", logger.Ctx{"networks": names})
+			cyclesReported = true
+		}
+
+		return sorted, cycles
 	}
 
-	// Check if project allows access to network.
-	if !project.NetworkAllowed(reqProjectConfig, networkName, n != nil && n.IsManaged()) {
-		return api.Network{}, api.StatusErrorf(http.StatusNotFound, "Network not found")
+	// Networks which failed to start are marked unavailable until started, possibly outside of this loop.
+	startFailed := make(map[network.ProjectNetwork]bool)
+
+	initNetwork := func(n network.Network) error {
+		err = n.Start()
+		if err != nil {
+			startFailed[network.ProjectNetwork{ProjectName: n.Project(), NetworkName: n.Name()}] = true
+			err = fmt.Errorf("Failed starting: %w", err)
+
+			_ = s.DB.Cluster.Transaction(s.ShutdownCtx, func(ctx context.Context, tx *db.ClusterTx) error {
+				return tx.UpsertWarningLocalNode(ctx, n.Project(), dbCluster.TypeNetwork, int(n.ID()), warningtype.NetworkUnvailable, err.Error())
+			})
+
+			return err
+		}
+
+		logger.Info("Initialized network", logger.Ctx{"project": n.Project(), "name": n.Name()})
+
+		// Network initialized successfully so remove it from the list so its not retried.
+		pn := network.ProjectNetwork{
+			ProjectName: n.Project(),
+			NetworkName: n.Name(),
+		}
+
+		delete(initNetworks, pn)
+
+		_ = warnings.ResolveWarningsByLocalNodeAndProjectAndTypeAndEntity(s.DB.Cluster, n.Project(), warningtype.NetworkUnvailable, dbCluster.TypeNetwork, int(n.ID()))
+
+		return nil
 	}
 
-	osInfo, _ := net.InterfaceByName(networkName)
+	loadAndInitNetwork := func(pn network.ProjectNetwork, firstPass bool) error {
+		var err error
+		var n network.Network
 
-	// Quick check.
-	if osInfo == nil && n == nil {
-		return api.Network{}, api.StatusErrorf(http.StatusNotFound, "Network not found")
+		if firstPass && loadedNetworks[pn] != nil {
+			// Check if network already loaded from during first pass phase.
+			n = loadedNetworks[pn]
+		} else {
+			n, err = network.LoadByName(s, pn.ProjectName, pn.NetworkName)
+			if err != nil {
+				if api.StatusErrorCheck(err, http.StatusNotFound) {
+					// Network has been deleted since we began trying to start it so delete
+					// entry.
+					delete(initNetworks, pn)
+
+					return nil
+				}
+
+				return fmt.Errorf("Failed loading: %w", err)
+			}
+
+			// A network which got started since its last failure, such as by a config update, is done.
+			if startFailed[pn] && n.LocalStatus() == api.NetworkStatusCreated {
+				logger.Info("Network got initialized", logger.Ctx{"project": n.Project(), "name": n.Name()})
+				delete(initNetworks, pn)
+
+				return nil
+			}
+
+			// Pick up dependency changes for the next attempts.
+			dependencies[pn] = networkStartupDependencies(n.Project(), n.Name(), n.Config())
+		}
+
+		netConfig := n.Config()
+		err = n.Validate(netConfig)
+		if err != nil {
+			return fmt.Errorf("Failed validating: %w", err)
+		}
+
+		return initNetwork(n)
 	}
 
-	// Prepare the response.
-	apiNet := api.Network{}
-	apiNet.Name = networkName
-	apiNet.UsedBy = []string{}
-	apiNet.Config = map[string]string{}
-	apiNet.Project = projectName
+	// Keep track of consecutive failures to only report the first few of them as errors.
+	failures := map[network.ProjectNetwork]int{}
+	logFailure := func(pn network.ProjectNetwork, err error) {
+		failures[pn]++
 
-	// Set the device type as needed.
-	if n != nil {
-		apiNet.Managed = true
-		apiNet.Description = n.Description()
-		apiNet.Type = n.Type()
+		logCtx := logger.Ctx{"project": pn.ProjectName, "network": pn.NetworkName, "attempt": failures[pn], "err": err}
+		if failures[pn] <= networkStartupErrorLogAttempts {
+			logger.Error("Failed initializing network", logCtx)
+		} else {
+			logger.Debug("Failed initializing network", logCtx)
+		}
+	}
+
+	// initNetworksInOrder tries initializing the remaining networks in dependency order, skipping those whose
+	// dependencies failed to initialize. Returns whether at least one network got initialized.
+	initNetworksInOrder := func(firstPass bool) bool {
+		initialized := false
+
+		pns, cycles := sortedNetworks()
+		for _, pn := range pns {
+			if !cycles[pn] {
+				idx := slices.IndexFunc(dependencies[pn], func(dep network.ProjectNetwork) bool {
+					_, pending := initNetworks[dep]
+
+					return pending
+				})
+
+				if idx >= 0 {
+					dep := dependencies[pn][idx]
+					logFailure(pn, fmt.Errorf("Dependency network %q in project %q isn't initialized", dep.NetworkName, dep.ProjectName))
+
+					continue
+				}
+			}
+
+			err := loadAndInitNetwork(pn, firstPass)
+			if err != nil {
+				logFailure(pn, err)
+
+				continue
+			}
+
+			delete(failures, pn)
+			initialized = true
+		}
+
+		return initialized
+	}
+
+	initNetworksInOrder(true)
+
+	loadedNetworks = nil // Don't store loaded networks after first pass.
+
+	// For any remaining networks that were not successfully initialized, we now start a go routine to
+	// periodically try to initialize them again in the background.
+	if len(initNetworks) > 0 {
+		go func() {
+			var interval time.Duration
+
+			for {
+				// Back off exponentially while no network can be initialized, up to the maximum interval.
+				retryInterval := time.Duration(s.GlobalConfig.NetworkStartupRetryInterval()) * time.Second
+				retryMaxInterval := max(time.Duration(s.GlobalConfig.NetworkStartupRetryMaxInterval())*time.Second, retryInterval)
+				if interval == 0 {
+					interval = retryInterval
+				} else {
+					interval = min(interval*2, retryMaxInterval)
+				}
+
+				t := time.NewTimer(interval)
+
+				select {
+				case <-s.ShutdownCtx.Done():
+					t.Stop()
+					return
+				case <-t.C:
+					t.Stop()
+
+					tryInstancesStart := initNetworksInOrder(false)
+
+					// Retry promptly again after progress was made as other networks may depend on it.
+					if tryInstancesStart {
+						interval = 0
+					}
+
+					remainingNetworks := len(initNetworks)
+					if remainingNetworks <= 0 {
+						logger.Info("All networks initialized")
+					}
+
+					// At least one remaining network was initialized, check if any instances
+					// can now start.
+					if tryInstancesStart {
+						instances, err := instance.LoadNodeAll(s, instancetype.Any)
+						if err != nil {
+							logger.Warn("Failed loading instances to start", logger.Ctx{"err": err})
+						} else {
+							instancesStart(s, instances)
+						}
+					}
+
+					if remainingNetworks <= 0 {
+						return // Our job here is done.
+					}
+				}
+			}
+		}()
+	} else {
+		logger.Info("All networks initialized")
+	}
+
+	return nil
+}
+
+// networkStartupOrder returns the pending networks, each after the networks it depends on and otherwise highest
+// startup priority first. Networks part of a dependency cycle can't be ordered, they are returned last and
+// reported in the returned cycles.
+func networkStartupOrder(pending map[network.ProjectNetwork]struct{}, priorities map[network.ProjectNetwork]int64, dependencies map[network.ProjectNetwork][]network.ProjectNetwork) ([]network.ProjectNetwork, map[network.ProjectNetwork]bool) {
+	pns := slices.Collect(maps.Keys(pending))
+	slices.SortFunc(pns, func(a network.ProjectNetwork, b network.ProjectNetwork) int {
+		return cmp.Or(
+			cmp.Compare(priorities[b], priorities[a]),
+			strings.Compare(a.ProjectName, b.ProjectName),
+			strings.Compare(a.NetworkName, b.NetworkName),
+		)
+	})
+
+	sorted := make([]network.ProjectNetwork, 0, len(pns))
+	added := make(map[network.ProjectNetwork]bool, len(pns))
+	for len(sorted) < len(pns) {
+		progress := false
+		for _, pn := range pns {
+			if added[pn] {
+				continue
+			}
+
+			// Dependencies which aren't pending are either running or not managed.
+			ready := !slices.ContainsFunc(dependencies[pn], func(dep network.ProjectNetwork) bool {
+				_, isPending := pending[dep]
+
+				return isPending && !added[dep]
+			})
+
+			if ready {
+				sorted = append(sorted, pn)
+				added[pn] = true
+				progress = true
+			}
+		}
+
+		if !progress {
+			break
+		}
+	}
+
+	cycles := make(map[network.ProjectNetwork]bool)
+	for _, pn := range pns {
+		if added[pn] {
+			continue
+		}
+
+		sorted = append(sorted, pn)
+		cycles[pn] = true
+	}
+
+	return sorted, cycles
+}
+
+// networkStartupDependencies returns the networks which must be running before the network can start, that is
+// its uplink network and its parent interface when that is a managed network.
+func networkStartupDependencies(projectName string, networkName string, config map[string]string) []network.ProjectNetwork {
+	var deps []network.ProjectNetwork
+
+	for _, key := range []string{"network", "parent"} {
+		name := config[key]
+		if name == "" || name == "none" {
+			continue
+		}
+
+		dep := network.ProjectNetwork{
+			ProjectName: api.ProjectDefaultName,
+			NetworkName: name,
+		}
+
+		if dep.ProjectName == projectName && dep.NetworkName == networkName {
+			continue
+		}
+
+		deps = append(deps, dep)
+	}
+
+	return deps
+}
+
+// networkShutdownMaxConcurrency is the maximum number of networks being stopped at once.
+const networkShutdownMaxConcurrency = 8
+
+func networkShutdown(s *state.State) {
+	var err error
+
+	// Get a list of projects.
+	var projectNames []string
+
+	err = s.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+		projectNames, err = dbCluster.GetProjectNames(ctx, tx.Tx())
+		return err
+	})
+	if err != nil {
+		logger.Error("Failed shutting down networks, couldn't load projects", logger.Ctx{"err": err})
+		return
+	}
+
+	// Load all the managed networks along with the networks they depend on.
+	networks := make(map[network.ProjectNetwork]network.Network)
+	dependencies := make(map[network.ProjectNetwork][]network.ProjectNetwork)
+	for _, projectName := range projectNames {
+		var networkNames []string
+
+		err := s.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+			// Get a list of managed networks.
+			networkNames, err = tx.GetNetworks(ctx, projectName)
+
+			return err
+		})
+		if err != nil {
+			logger.Error("Failed shutting down networks, couldn't load networks for project", logger.Ctx{"project": projectName, "err": err})
+			continue
+		}
+
+		for _, name := range networkNames {
+			n, err := network.LoadByName(s, projectName, name)
+			if err != nil {
+				logger.Error("Failed shutting down network, couldn't load network", logger.Ctx{"network": name, "project": projectName, "err": err})
+				continue
+			}
 
-		err = s.Authorizer.CheckPermission(r.Context(), r, auth.ObjectNetwork(projectName, networkName), auth.EntitlementCanEdit)
-		if err == nil {
-			// Only allow admins to see network config as sensitive info can be stored there.
-			apiNet.Config = n.Config()
-		} else if !api.StatusErrorCheck(err, http.StatusForbidden) {
-			return api.Network{}, err
+			pn := network.ProjectNetwork{ProjectName: projectName, NetworkName: name}
+			networks[pn] = n
+			dependencies[pn] = networkStartupDependencies(n.Project(), n.Name(), n.Config())
 		}
+	}
 
-		// If no member is specified, we omit the node-specific fields.
-		if allNodes {
-			apiNet.Config = db.StripNodeSpecificNetworkConfig(apiNet.Config)
+	// Bring them all down, in waves so that networks are stopped before the networks they depend on.
+	for len(networks) > 0 {
+		// Networks still depended upon by a network left running can't be stopped yet.
+		dependedOn := make(map[network.ProjectNetwork]bool)
+		for pn := range networks {
+			for _, dep := range dependencies[pn] {
+				dependedOn[dep] = true
+			}
 		}
-	} else if osInfo != nil && int(osInfo.Flags&net.FlagLoopback) > 0 {
-		apiNet.Type = "loopback"
-	} else if util.PathExists(fmt.Sprintf("/sys/class/net/%s/bridge", apiNet.Name)) {
-		apiNet.Type = "bridge"
-	} else if util.PathExists(fmt.Sprintf("/proc/net/vlan/%s", apiNet.Name)) {
-		apiNet.Type = "vlan"
-	} else if util.PathExists(fmt.Sprintf("/sys/class/net/%s/device", apiNet.Name)) {
-		apiNet.Type = "physical"
-	} else if util.PathExists(fmt.Sprintf("/sys/class/net/%s/bonding", apiNet.Name)) {
-		apiNet.Type = "bond"
-	} else {
-		vswitch, err := s.OVS()
-		if err != nil {
-			return api.Network{}, fmt.Errorf("Failed to connect to OVS: %w", err)
+
+		var wave []network.ProjectNetwork
+		for pn := range networks {
+			if !dependedOn[pn] {
+				wave = append(wave, pn)
+			}
 		}
 
-		_, err = vswitch.GetBridge(context.TODO(), apiNet.Name)
-		if err == nil {
-			apiNet.Type = "bridge"
-		} else {
-			apiNet.Type = "unknown"
+		// Stop all remaining networks if they depend on each other.
+		if len(wave) == 0 {
+			wave = slices.Collect(maps.Keys(networks))
 		}
-	}
 
-	// Look for instances using the interface.
-	if apiNet.Type != "loopback" {
-		var networkID int64
-		if n != nil {
-			networkID = n.ID()
+		wg := sync.WaitGroup{}
+		sem := make(chan struct{}, networkShutdownMaxConcurrency)
+		for _, pn := range wave {
+			n := networks[pn]
+			delete(networks, pn)
+
+			wg.Add(1)
+			sem <- struct{}{}
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				err := n.Stop()
+				if err != nil {
+					logger.Error("Failed to bring down network", logger.Ctx{"err": err, "project": pn.ProjectName, "name": pn.NetworkName})
+				}
+			}()
 		}
 
-		usedBy, err := network.UsedBy(s, projectName, networkID, apiNet.Name, apiNet.Type, false)
+		wg.Wait()
+	}
+}
+
+// networkRestartOVN is used to trigger a restart of the OVN networks, optionally limited to a project and
+// network name. Restarting is best effort, all matching networks are attempted and their errors combined.
+func networkRestartOVN(s *state.State, projectName string, networkName string) error {
+	logger.Info("Restarting OVN networks", logger.Ctx{"project": projectName, "network": networkName})
+
+	// Get a list of projects.
+	projectNames := []string{projectName}
+	if projectName == "" {
+		var err error
+		err = s.DB.Cluster.Transaction(s.ShutdownCtx, func(ctx context.Context, tx *db.ClusterTx) error {
+			projectNames, err = dbCluster.GetProjectNames(ctx, tx.Tx())
+			return err
+		})
 		if err != nil {
-			return api.Network{}, err
+			return fmt.Errorf("Failed to load projects: %w", err)
 		}
-
-		apiNet.UsedBy = project.FilterUsedBy(s.Authorizer, r, usedBy)
 	}
 
-	if n != nil {
-		if allNodes {
-			apiNet.Status = n.Status()
-		} else {
-			apiNet.Status = n.LocalStatus()
+	// Go over all the networks in every project.
+	var errs []error
+	for _, projectName := range projectNames {
+		networkNames := []string{networkName}
+		if networkName == "" {
+			var err error
+			err = s.DB.Cluster.Transaction(s.ShutdownCtx, func(ctx context.Context, tx *db.ClusterTx) error {
+				networkNames, err = tx.GetCreatedNetworkNamesByProject(ctx, projectName)
+
+				return err
+			})
+			if err != nil {
+				errs = append(errs, fmt.Errorf("Failed to load networks for project %q: %w", projectName, err))
+				continue
+			}
 		}
 
-		apiNet.Locations = n.Locations()
+		for _, networkName := range networkNames {
+			// Load the network struct.
+			n, err := network.LoadByName(s, projectName, networkName)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("Failed to load network %q in project %q: %w", networkName, projectName, err))
+				continue
+			}
+
+			// Skip non-OVN networks.
+			if n.DBType() != db.NetworkTypeOVN {
+				continue
+			}
+
+			// Restart the network.
+			err = n.Start()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("Failed to restart network %q in project %q: %w", networkName, projectName, err))
+				continue
+			}
+		}
 	}
 
-	return apiNet, nil
+	return errors.Join(errs...)
 }
 
-// swagger:operation DELETE /1.0/networks/{name} networks network_delete
+// swagger:operation GET /1.0/networks/{name}/state networks networks_state_get
 //
-//	Delete the network
+//	Get the network state
 //
-//	Removes the network.
+//	Returns the current network state information.
+//	For unmanaged interfaces, this is the state of the interface on the targeted cluster member.
 //
 //	---
 //	produces:
@@ -1054,18 +4647,78 @@ func doNetworkGet(s *state.State, r *http.Request, allNodes bool, projectName st
 //	    description: Project name
 //	    type: string
 //	    example: default
+//	  - in: query
+//	    name: target
+//	    description: Cluster member name
+//	    type: string
+//	    example: server01
+//	  - in: query
+//	    name: field
+//	    description: Only return the specified state field (currently only "dhcp.utilization")
+//	    type: string
+//	    example: dhcp.utilization
+//	  - in: query
+//	    name: all-members
+//	    description: Retrieve the state from all cluster members (returns a map keyed by member name)
+//	    type: boolean
+//	    example: true
+//	  - in: query
+//	    name: detail
+//	    description: Include additional details ("queues" for per-queue information, "ports" for the instance ports of OVN networks, "qos" for the traffic limits applied to the network ports or "dhcp" for the utilization of the DHCP address pools)
+//	    type: string
+//	    example: queues
 //	responses:
 //	  "200":
-//	    $ref: "#/responses/EmptySyncResponse"
-//	  "400":
-//	    $ref: "#/responses/BadRequest"
+//	    description: API endpoints
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          $ref: "#/definitions/NetworkState"
 //	  "403":
 //	    $ref: "#/responses/Forbidden"
 //	  "500":
 //	    $ref: "#/responses/InternalServerError"
-func networkDelete(d *Daemon, r *http.Request) response.Response {
+func networkStateGet(d *Daemon, r *http.Request) response.Response {
 	s := d.State()
 
+	allMembers := util.IsTrue(request.QueryParam(r, "all-members"))
+	if allMembers && request.QueryParam(r, "target") != "" {
+		return response.BadRequest(errors.New("The all-members and target options can't be combined"))
+	}
+
+	if allMembers && request.QueryParam(r, "field") != "" {
+		return response.BadRequest(errors.New("The all-members and field options can't be combined"))
+	}
+
+	detail := request.QueryParam(r, "detail")
+	if detail != "" && detail != "queues" && detail != "ports" && detail != "qos" && detail != "dhcp" {
+		return response.BadRequest(fmt.Errorf("Invalid detail %q", detail))
+	}
+
+	if allMembers && detail != "" {
+		return response.BadRequest(errors.New("The all-members and detail options can't be combined"))
+	}
+
+	// If a target was specified, forward the request to the relevant node.
+	resp := forwardedResponseIfTargetIsRemote(s, r)
+	if resp != nil {
+		return resp
+	}
+
 	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
 	if err != nil {
 		return response.SmartError(err)
@@ -1076,223 +4729,252 @@ func networkDelete(d *Daemon, r *http.Request) response.Response {
 		return response.SmartError(err)
 	}
 
-	// Get the existing network.
 	n, err := network.LoadByName(s, projectName, networkName)
-	if err != nil {
+	if err != nil && !api.StatusErrorCheck(err, http.StatusNotFound) {
 		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
 	}
 
 	// Check if project allows access to network.
-	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+	if !project.NetworkAllowed(reqProject.Config, networkName, n != nil && n.IsManaged()) {
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
-	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
+	// Return a single scalar field if requested.
+	field := request.QueryParam(r, "field")
+	if field != "" {
+		return networkStateField(r, n, field)
+	}
 
-	clusterNotification := isClusterNotification(r)
-	if !clusterNotification {
-		// Quick checks.
-		inUse, err := n.IsUsed(false)
+	var state *api.NetworkState
+	if n != nil {
+		state, err = n.State()
 		if err != nil {
 			return response.SmartError(err)
 		}
 
-		if inUse {
-			return response.BadRequest(errors.New("The network is currently in use"))
+		// Report the remaining OVN ranges capacity for the networks which can be used as OVN uplinks.
+		isUplink := n.Type() == "physical" || (n.Type() == "bridge" && (n.Config()["ipv4.ovn.ranges"] != "" || n.Config()["ipv6.ovn.ranges"] != ""))
+		if state != nil && n.Project() == api.ProjectDefaultName && isUplink {
+			state.OVNUplinkCapacity, err = network.OVNUplinkCapacity(s, n)
+			if err != nil {
+				logger.Warn("Failed getting OVN uplink capacity", logger.Ctx{"project": n.Project(), "network": n.Name(), "err": err})
+				state.OVNUplinkCapacity = nil
+			}
 		}
-	}
 
-	if n.LocalStatus() != api.NetworkStatusPending {
-		err = n.Delete(clientType)
+	} else {
+		state, err = resources.GetNetworkState(networkName)
 		if err != nil {
-			return response.InternalError(err)
+			if !s.ServerClustered || !api.StatusErrorCheck(err, http.StatusNotFound) {
+				return response.SmartError(err)
+			}
+
+			err = api.StatusErrorf(http.StatusNotFound, "Network interface %q not found on member %q", networkName, s.ServerName)
+
+			// Unmanaged interfaces may only exist on some of the members.
+			if !allMembers {
+				return response.SmartError(err)
+			}
+
+			state = &api.NetworkState{Error: err.Error()}
 		}
 	}
 
-	// If this is a cluster notification, we're done, any database work will be done by the node that is
-	// originally serving the request.
-	if clusterNotification {
-		return response.EmptySyncResponse
-	}
+	// Add the utilization of the DHCP address pools if requested.
+	if detail == "dhcp" && state != nil {
+		if n == nil {
+			return response.BadRequest(errors.New("DHCP information is only available for managed networks"))
+		}
 
-	// If we are clustered, also notify all other nodes, if any.
-	if s.ServerClustered {
-		notifier, err := cluster.NewNotifier(s, s.Endpoints.NetworkCert(), s.ServerCert(), cluster.NotifyAll)
+		state.DHCPPools, err = networkDHCPPools(n, clusterRequest.UserAgentClientType(r.Header.Get("User-Agent")))
 		if err != nil {
 			return response.SmartError(err)
 		}
+	}
 
-		err = notifier(func(client incus.InstanceServer) error {
-			return client.UseProject(n.Project()).DeleteNetwork(n.Name())
-		})
+	// Add the per-queue information if requested.
+	if detail == "queues" && state != nil {
+		if n != nil && n.Type() == "ovn" {
+			return response.BadRequest(errors.New("Queue information isn't available for OVN networks"))
+		}
+
+		state.Queues, err = resources.GetNetworkQueues(networkName)
 		if err != nil {
 			return response.SmartError(err)
 		}
 	}
 
-	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
-		// Remove the network from the database.
-		err = tx.DeleteNetwork(ctx, n.Project(), n.Name())
+	// Add the instance ports if requested.
+	if detail == "ports" && state != nil {
+		if n == nil {
+			return response.BadRequest(errors.New("Port information is only available for managed networks"))
+		}
 
-		return err
-	})
-	if err != nil {
-		return response.SmartError(err)
-	}
+		state.Ports, err = n.PortBindings(reqProject.Name)
+		if err != nil {
+			if errors.Is(err, network.ErrNotImplemented) {
+				return response.BadRequest(fmt.Errorf("Port information isn't available for %q networks", n.Type()))
+			}
 
-	err = s.Authorizer.DeleteNetwork(r.Context(), projectName, networkName)
-	if err != nil {
-		logger.Error("Failed to remove network from authorizer", logger.Ctx{"name": networkName, "project": projectName, "error": err})
+			return response.SmartError(err)
+		}
 	}
 
-	requestor := request.CreateRequestor(r)
-	s.Events.SendLifecycle(projectName, lifecycle.NetworkDeleted.Event(n, requestor, nil))
+	// Add the traffic limits applied to the network ports if requested.
+	if detail == "qos" && state != nil {
+		state.QoS = []api.NetworkStateQoSPort{}
+		if n != nil {
+			qos, err := n.QoS()
+			if err != nil && !errors.Is(err, network.ErrNotImplemented) {
+				return response.SmartError(err)
+			}
 
-	return response.EmptySyncResponse
-}
+			if qos != nil {
+				state.QoS = qos
+			}
+		}
+	}
 
-// swagger:operation POST /1.0/networks/{name} networks network_post
-//
-//	Rename the network
-//
-//	Renames an existing network.
-//
-//	---
-//	consumes:
-//	  - application/json
-//	produces:
-//	  - application/json
-//	parameters:
-//	  - in: query
-//	    name: project
-//	    description: Project name
-//	    type: string
-//	    example: default
-//	  - in: body
-//	    name: network
-//	    description: Network rename request
-//	    required: true
-//	    schema:
-//	      $ref: "#/definitions/NetworkPost"
-//	responses:
-//	  "200":
-//	    $ref: "#/responses/EmptySyncResponse"
-//	  "400":
-//	    $ref: "#/responses/BadRequest"
-//	  "403":
-//	    $ref: "#/responses/Forbidden"
-//	  "500":
-//	    $ref: "#/responses/InternalServerError"
-func networkPost(d *Daemon, r *http.Request) response.Response {
-	s := d.State()
+	// Only allow editors to see the OVN logical topology as it exposes internal addressing.
+	canEdit := false
+	if n != nil {
+		err = s.Authorizer.CheckPermission(r.Context(), r, auth.ObjectNetwork(projectName, networkName), auth.EntitlementCanEdit)
+		if err == nil {
+			canEdit = true
+		} else if !api.StatusErrorCheck(err, http.StatusForbidden) {
+			return response.SmartError(err)
+		}
+	}
 
-	// FIXME: renaming a network is currently not supported in clustering
-	//        mode. The difficulty is that network.Start() depends on the
-	//        network having already been renamed in the database, which is
-	//        a chicken-and-egg problem for cluster notifications (the
-	//        serving node should typically do the database job, so the
-	//        network is not yet renamed in the db when the notified node
-	//        runs network.Start).
-	if s.ServerClustered {
-		return response.BadRequest(errors.New("Renaming clustered network not supported"))
+	hideTopology := func(state *api.NetworkState) {
+		if !canEdit && state != nil && state.OVN != nil {
+			state.OVN.Topology = nil
+		}
+	}
+
+	hideTopology(state)
+
+	if !allMembers {
+		return networkStateResponse(r, state)
 	}
 
-	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
-	if err != nil {
-		return response.SmartError(err)
+	// A standalone server is the only member.
+	if !s.ServerClustered {
+		return networkStateResponse(r, map[string]*api.NetworkState{s.ServerName: state})
 	}
 
-	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	memberStates, memberErrs, err := networkMembersCollect(s, r, func(client incus.InstanceServer) (*api.NetworkState, error) {
+		return client.UseProject(projectName).GetNetworkState(networkName)
+	})
 	if err != nil {
 		return response.SmartError(err)
 	}
 
-	req := api.NetworkPost{}
+	// Report the members which couldn't be reached rather than failing the whole request.
+	for memberName, memberErr := range memberErrs {
+		memberStates[memberName] = &api.NetworkState{Error: fmt.Sprintf("Failed getting network state: %v", memberErr)}
+	}
 
-	// Parse the request.
-	err = json.NewDecoder(r.Body).Decode(&req)
-	if err != nil {
-		return response.BadRequest(err)
+	for _, memberState := range memberStates {
+		hideTopology(memberState)
 	}
 
-	// Get the existing network.
-	n, err := network.LoadByName(s, projectName, networkName)
+	memberStates[s.ServerName] = state
+
+	return networkStateResponse(r, memberStates)
+}
+
+// networkStateResponse returns the network state along with an etag over it, letting pollers skip unchanged
+// state (counters and addresses included) through If-None-Match.
+func networkStateResponse(r *http.Request, state any) response.Response {
+	notModified, err := localUtil.EtagNoneMatch(r, state)
 	if err != nil {
-		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+		return response.SmartError(err)
 	}
 
-	// Check if project allows access to network.
-	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+	if notModified {
+		return response.NotModified(state)
 	}
 
-	if n.Status() != api.NetworkStatusCreated {
-		return response.BadRequest(errors.New("Cannot rename network when not in created state"))
-	}
+	return response.SyncResponseETag(true, state, state)
+}
 
-	// Ensure new name is supplied.
-	if req.Name == "" {
-		return response.BadRequest(errors.New("New network name not provided"))
+// networkDHCPPools returns the utilization of the network's DHCP address pools.
+func networkDHCPPools(n network.Network, clientType clusterRequest.ClientType) ([]api.NetworkStateDHCPPool, error) {
+	if n.DHCPv4Subnet() == nil && n.DHCPv6Subnet() == nil {
+		return nil, nil
 	}
 
-	err = n.ValidateName(req.Name)
+	leases, err := n.Leases(n.Project(), clientType)
 	if err != nil {
-		return response.BadRequest(err)
-	}
+		if errors.Is(err, network.ErrNotImplemented) {
+			return nil, nil
+		}
 
-	// Check network isn't in use.
-	inUse, err := n.IsUsed(false)
-	if err != nil {
-		return response.InternalError(fmt.Errorf("Failed checking network in use: %w", err))
+		return nil, err
 	}
 
-	if inUse {
-		return response.BadRequest(errors.New("Network is currently in use"))
+	pools := []api.NetworkStateDHCPPool{}
+	usageFuncs := map[string]func(network.Network, []api.NetworkLease) (uint64, uint64, error){
+		"inet":  network.DHCPv4PoolUsage,
+		"inet6": network.DHCPv6PoolUsage,
 	}
 
-	var networks []string
+	for _, family := range []string{"inet", "inet6"} {
+		allocated, total, err := usageFuncs[family](n, leases)
+		if err != nil {
+			// The address family doesn't have a DHCP pool.
+			if api.StatusErrorCheck(err, http.StatusBadRequest) {
+				continue
+			}
 
-	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
-		// Check that the name isn't already in used by an existing managed network.
-		networks, err = tx.GetNetworks(ctx, projectName)
+			return nil, err
+		}
 
-		return err
-	})
-	if err != nil {
-		return response.InternalError(err)
+		pools = append(pools, api.NetworkStateDHCPPool{Family: family, Allocated: allocated, Total: total})
 	}
 
-	if slices.Contains(networks, req.Name) {
-		return response.Conflict(fmt.Errorf("Network %q already exists", req.Name))
-	}
+	return pools, nil
+}
 
-	// Rename it.
-	err = n.Rename(req.Name)
-	if err != nil {
-		return response.SmartError(err)
-	}
+// networkStateField returns a single computed field of the network state.
+func networkStateField(r *http.Request, n network.Network, field string) response.Response {
+	switch field {
+	case "dhcp.utilization":
+		if n == nil {
+			return response.BadRequest(errors.New("DHCP utilization is only available for managed networks"))
+		}
 
-	err = s.Authorizer.RenameNetwork(r.Context(), projectName, networkName, req.Name)
-	if err != nil {
-		logger.Error("Failed to rename network in authorizer", logger.Ctx{"old_name": networkName, "new_name": req.Name, "project": projectName, "error": err})
-	}
+		clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
+		leases, err := n.Leases(n.Project(), clientType)
+		if err != nil {
+			return response.SmartError(err)
+		}
 
-	requestor := request.CreateRequestor(r)
-	lc := lifecycle.NetworkRenamed.Event(n, requestor, map[string]any{"old_name": networkName})
-	s.Events.SendLifecycle(projectName, lc)
+		allocated, total, err := network.DHCPv4PoolUsage(n, leases)
+		if err != nil {
+			return response.SmartError(err)
+		}
 
-	return response.SyncResponseLocation(true, nil, lc.Source)
+		utilization := float64(0)
+		if total > 0 {
+			utilization = float64(allocated) / float64(total)
+		}
+
+		return response.SyncResponse(true, utilization)
+	}
+
+	return response.BadRequest(fmt.Errorf("Unknown network state field %q", field))
 }
 
-// swagger:operation PUT /1.0/networks/{name} networks network_put
+// swagger:operation GET /1.0/networks/{name}/stats networks networks_stats_get
 //
-//	Update the network
+//	Get the network traffic statistics
 //
-//	Updates the entire network configuration.
+//	Returns the traffic counters of the network along with those of the connected instances of the project.
+//	The statistics are local to the cluster member handling the request.
 //
 //	---
-//	consumes:
-//	  - application/json
 //	produces:
 //	  - application/json
 //	parameters:
@@ -1306,24 +4988,34 @@ func networkPost(d *Daemon, r *http.Request) response.Response {
 //	    description: Cluster member name
 //	    type: string
 //	    example: server01
-//	  - in: body
-//	    name: network
-//	    description: Network configuration
-//	    required: true
-//	    schema:
-//	      $ref: "#/definitions/NetworkPut"
 //	responses:
 //	  "200":
-//	    $ref: "#/responses/EmptySyncResponse"
+//	    description: Network statistics
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          $ref: "#/definitions/NetworkStats"
 //	  "400":
 //	    $ref: "#/responses/BadRequest"
 //	  "403":
 //	    $ref: "#/responses/Forbidden"
-//	  "412":
-//	    $ref: "#/responses/PreconditionFailed"
 //	  "500":
 //	    $ref: "#/responses/InternalServerError"
-func networkPut(d *Daemon, r *http.Request) response.Response {
+func networkStatsGet(d *Daemon, r *http.Request) response.Response {
 	s := d.State()
 
 	// If a target was specified, forward the request to the relevant node.
@@ -1342,7 +5034,6 @@ func networkPut(d *Daemon, r *http.Request) response.Response {
 		return response.SmartError(err)
 	}
 
-	// Get the existing network.
 	n, err := network.LoadByName(s, projectName, networkName)
 	if err != nil {
 		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
@@ -1350,171 +5041,26 @@ func networkPut(d *Daemon, r *http.Request) response.Response {
 
 	// Check if project allows access to network.
 	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
-	}
-
-	targetNode := request.QueryParam(r, "target")
-
-	if targetNode == "" && n.Status() != api.NetworkStatusCreated {
-		return response.BadRequest(errors.New("Cannot update network global config when not in created state"))
-	}
-
-	// Duplicate config for etag modification and generation.
-	etagConfig := localUtil.CopyConfig(n.Config())
-
-	// If no target node is specified and the daemon is clustered, we omit the node-specific fields so that
-	// the e-tag can be generated correctly. This is because the GET request used to populate the request
-	// will also remove node-specific keys when no target is specified.
-	if targetNode == "" && s.ServerClustered {
-		etagConfig = db.StripNodeSpecificNetworkConfig(etagConfig)
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
-	// Validate the ETag.
-	etag := []any{n.Name(), n.IsManaged(), n.Type(), n.Description(), etagConfig}
-	err = localUtil.EtagCheck(r, etag)
-	if err != nil {
-		return response.PreconditionFailed(err)
-	}
-
-	// Decode the request.
-	req := api.NetworkPut{}
-	err = json.NewDecoder(r.Body).Decode(&req)
+	stats, err := n.Stats(reqProject.Name)
 	if err != nil {
-		return response.BadRequest(err)
-	}
-
-	// In clustered mode, we differentiate between node specific and non-node specific config keys based on
-	// whether the user has specified a target to apply the config to.
-	if s.ServerClustered {
-		curConfig := n.Config()
-		changedConfig := make(map[string]string, len(req.Config))
-		for key, value := range req.Config {
-			if curConfig[key] == value {
-				continue
-			}
-
-			changedConfig[key] = value
-		}
-
-		if targetNode == "" {
-			// If no target is specified, then ensure only non-node-specific config keys are changed.
-			for k := range changedConfig {
-				if db.IsNodeSpecificNetworkConfig(k) {
-					return response.BadRequest(fmt.Errorf("Config key %q is cluster member specific", k))
-				}
-			}
-		} else {
-			// If a target is specified, then ensure only node-specific config keys are changed.
-			for k := range changedConfig {
-				if !db.IsNodeSpecificNetworkConfig(k) {
-					return response.BadRequest(fmt.Errorf("Config key %q may not be used as member-specific key", k))
-				}
-			}
-		}
-	}
-
-	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
-
-	resp = doNetworkUpdate(n, req, targetNode, clientType, r.Method, s.ServerClustered)
-
-	requestor := request.CreateRequestor(r)
-	s.Events.SendLifecycle(projectName, lifecycle.NetworkUpdated.Event(n, requestor, nil))
-
-	return resp
-}
-
-// swagger:operation PATCH /1.0/networks/{name} networks network_patch
-//
-//	Partially update the network
-//
-//	Updates a subset of the network configuration.
-//
-//	---
-//	consumes:
-//	  - application/json
-//	produces:
-//	  - application/json
-//	parameters:
-//	  - in: query
-//	    name: project
-//	    description: Project name
-//	    type: string
-//	    example: default
-//	  - in: query
-//	    name: target
-//	    description: Cluster member name
-//	    type: string
-//	    example: server01
-//	  - in: body
-//	    name: network
-//	    description: Network configuration
-//	    required: true
-//	    schema:
-//	      $ref: "#/definitions/NetworkPut"
-//	responses:
-//	  "200":
-//	    $ref: "#/responses/EmptySyncResponse"
-//	  "400":
-//	    $ref: "#/responses/BadRequest"
-//	  "403":
-//	    $ref: "#/responses/Forbidden"
-//	  "412":
-//	    $ref: "#/responses/PreconditionFailed"
-//	  "500":
-//	    $ref: "#/responses/InternalServerError"
-func networkPatch(d *Daemon, r *http.Request) response.Response {
-	return networkPut(d, r)
-}
-
-// doNetworkUpdate loads the current local network config, merges with the requested network config, validates
-// and applies the changes. Will also notify other cluster nodes of non-node specific config if needed.
-func doNetworkUpdate(n network.Network, req api.NetworkPut, targetNode string, clientType clusterRequest.ClientType, httpMethod string, clustered bool) response.Response {
-	if req.Config == nil {
-		req.Config = map[string]string{}
-	}
-
-	// Normally a "put" request will replace all existing config, however when clustered, we need to account
-	// for the node specific config keys and not replace them when the request doesn't specify a specific node.
-	if targetNode == "" && httpMethod != http.MethodPatch && clustered {
-		// If non-node specific config being updated via "put" method in cluster, then merge the current
-		// node-specific network config with the submitted config to allow validation.
-		// This allows removal of non-node specific keys when they are absent from request config.
-		for k, v := range n.Config() {
-			if db.IsNodeSpecificNetworkConfig(k) {
-				req.Config[k] = v
-			}
-		}
-	} else if httpMethod == http.MethodPatch {
-		// If config being updated via "patch" method, then merge all existing config with the keys that
-		// are present in the request config.
-		for k, v := range n.Config() {
-			_, ok := req.Config[k]
-			if !ok {
-				req.Config[k] = v
-			}
+		if errors.Is(err, network.ErrNotImplemented) {
+			return response.BadRequest(fmt.Errorf("Statistics aren't available for %q networks", n.Type()))
 		}
-	}
-
-	// Validate the merged configuration.
-	err := n.Validate(req.Config)
-	if err != nil {
-		return response.BadRequest(err)
-	}
 
-	// Apply the new configuration (will also notify other cluster nodes if needed).
-	err = n.Update(req, targetNode, clientType)
-	if err != nil {
 		return response.SmartError(err)
 	}
 
-	return response.EmptySyncResponse
+	return response.SyncResponse(true, stats)
 }
 
-// swagger:operation GET /1.0/networks/{name}/leases networks networks_leases_get
+// swagger:operation GET /1.0/networks/{name}/connectivity networks network_connectivity_get
 //
-//	Get the DHCP leases
+//	Test the network gateway reachability
 //
-//	Returns a list of DHCP leases for the network.
+//	Probes the network's gateways from the cluster member and returns whether they are reachable.
 //
 //	---
 //	produces:
@@ -1530,9 +5076,14 @@ func doNetworkUpdate(n network.Network, req api.NetworkPut, targetNode string, c
 //	    description: Cluster member name
 //	    type: string
 //	    example: server01
+//	  - in: query
+//	    name: all-members
+//	    description: Run the test from all cluster members (returns a list)
+//	    type: boolean
+//	    example: true
 //	responses:
 //	  "200":
-//	    description: API endpoints
+//	    description: Connectivity test result
 //	    schema:
 //	      type: object
 //	      description: Sync response
@@ -1550,17 +5101,27 @@ func doNetworkUpdate(n network.Network, req api.NetworkPut, targetNode string, c
 //	          description: Status code
 //	          example: 200
 //	        metadata:
-//	          type: array
-//	          description: List of DHCP leases
-//	          items:
-//	            $ref: "#/definitions/NetworkLease"
+//	          $ref: "#/definitions/NetworkConnectivity"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
 //	  "403":
 //	    $ref: "#/responses/Forbidden"
 //	  "500":
 //	    $ref: "#/responses/InternalServerError"
-func networkLeasesGet(d *Daemon, r *http.Request) response.Response {
+func networkConnectivityGet(d *Daemon, r *http.Request) response.Response {
 	s := d.State()
 
+	allMembers := util.IsTrue(request.QueryParam(r, "all-members"))
+	if allMembers && request.QueryParam(r, "target") != "" {
+		return response.BadRequest(errors.New("The all-members and target options can't be combined"))
+	}
+
+	// If a target was specified, forward the request to the relevant node.
+	resp := forwardedResponseIfTargetIsRemote(s, r)
+	if resp != nil {
+		return resp
+	}
+
 	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
 	if err != nil {
 		return response.SmartError(err)
@@ -1571,7 +5132,6 @@ func networkLeasesGet(d *Daemon, r *http.Request) response.Response {
 		return response.SmartError(err)
 	}
 
-	// Attempt to load the network.
 	n, err := network.LoadByName(s, projectName, networkName)
 	if err != nil {
 		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
@@ -1579,333 +5139,358 @@ func networkLeasesGet(d *Daemon, r *http.Request) response.Response {
 
 	// Check if project allows access to network.
 	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
-	clientType := clusterRequest.UserAgentClientType(r.Header.Get("User-Agent"))
-	leases, err := n.Leases(reqProject.Name, clientType)
+	result, err := network.ProbeGateways(s, n)
 	if err != nil {
 		return response.SmartError(err)
 	}
 
-	return response.SyncResponse(true, leases)
-}
-
-func networkStartup(s *state.State) error {
-	var err error
-
-	// Get a list of projects.
-	var projectNames []string
+	if !allMembers {
+		return response.SyncResponse(true, result)
+	}
 
-	err = s.DB.Cluster.Transaction(s.ShutdownCtx, func(ctx context.Context, tx *db.ClusterTx) error {
-		projectNames, err = dbCluster.GetProjectNames(ctx, tx.Tx())
-		return err
+	memberResults, memberErrs, err := networkMembersCollect(s, r, func(client incus.InstanceServer) (*api.NetworkConnectivity, error) {
+		return client.UseProject(n.Project()).GetNetworkConnectivity(n.Name())
 	})
 	if err != nil {
-		return fmt.Errorf("Failed to load projects: %w", err)
+		return response.SmartError(err)
 	}
 
-	// Build a list of networks to initialize, keyed by project and network name.
-	const networkPriorityStandalone = 0 // Start networks not dependent on any other network first.
-	const networkPriorityPhysical = 1   // Start networks dependent on physical interfaces second.
-	const networkPriorityLogical = 2    // Start networks dependent logical networks third.
-	initNetworks := []map[network.ProjectNetwork]struct{}{
-		networkPriorityStandalone: make(map[network.ProjectNetwork]struct{}),
-		networkPriorityPhysical:   make(map[network.ProjectNetwork]struct{}),
-		networkPriorityLogical:    make(map[network.ProjectNetwork]struct{}),
+	results := []api.NetworkConnectivity{*result}
+	for _, memberResult := range memberResults {
+		results = append(results, *memberResult)
 	}
 
-	err = s.DB.Cluster.Transaction(s.ShutdownCtx, func(ctx context.Context, tx *db.ClusterTx) error {
-		for _, projectName := range projectNames {
-			networkNames, err := tx.GetCreatedNetworkNamesByProject(ctx, projectName)
-			if err != nil {
-				return fmt.Errorf("Failed to load networks for project %q: %w", projectName, err)
-			}
+	for memberName, memberErr := range memberErrs {
+		results = append(results, api.NetworkConnectivity{
+			Location: memberName,
+			Gateways: []api.NetworkConnectivityGateway{},
+			Error:    memberErr.Error(),
+		})
+	}
 
-			for _, networkName := range networkNames {
-				pn := network.ProjectNetwork{
-					ProjectName: projectName,
-					NetworkName: networkName,
-				}
+	slices.SortFunc(results, func(a api.NetworkConnectivity, b api.NetworkConnectivity) int {
+		return strings.Compare(a.Location, b.Location)
+	})
 
-				// Assume all networks are networkPriorityStandalone initially.
-				initNetworks[networkPriorityStandalone][pn] = struct{}{}
-			}
-		}
+	return response.SyncResponse(true, results)
+}
 
-		return nil
-	})
+// swagger:operation POST /1.0/networks/{name}/regenerate networks network_regenerate_post
+//
+//	Regenerate the network configuration
+//
+//	Reloads the network from the database and has its driver re-apply the runtime configuration
+//	(dnsmasq configuration, firewall rules, OVN objects, ...) without stopping the network first.
+//	This is useful to reconcile the running network after the database was changed out of band.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    $ref: "#/responses/EmptySyncResponse"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkRegeneratePost(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
 	if err != nil {
-		return err
+		return response.SmartError(err)
 	}
 
-	loadedNetworks := make(map[network.ProjectNetwork]network.Network)
-
-	initNetwork := func(n network.Network, priority int) error {
-		err = n.Start()
-		if err != nil {
-			err = fmt.Errorf("Failed starting: %w", err)
-
-			_ = s.DB.Cluster.Transaction(s.ShutdownCtx, func(ctx context.Context, tx *db.ClusterTx) error {
-				return tx.UpsertWarningLocalNode(ctx, n.Project(), dbCluster.TypeNetwork, int(n.ID()), warningtype.NetworkUnvailable, err.Error())
-			})
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
 
-			return err
-		}
+	// Load the network from the database so that its current record is what gets applied.
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
 
-		logger.Info("Initialized network", logger.Ctx{"project": n.Project(), "name": n.Name()})
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
+	}
 
-		// Network initialized successfully so remove it from the list so its not retried.
-		pn := network.ProjectNetwork{
-			ProjectName: n.Project(),
-			NetworkName: n.Name(),
-		}
+	if n.Status() != api.NetworkStatusCreated {
+		return response.BadRequest(errors.New("Cannot regenerate network when not in created state"))
+	}
 
-		delete(initNetworks[priority], pn)
+	err = n.Validate(n.Config())
+	if err != nil {
+		return response.BadRequest(fmt.Errorf("Stored network config is invalid: %w", err))
+	}
 
-		_ = warnings.ResolveWarningsByLocalNodeAndProjectAndTypeAndEntity(s.DB.Cluster, n.Project(), warningtype.NetworkUnvailable, dbCluster.TypeNetwork, int(n.ID()))
+	// Starting an already running network re-applies its configuration.
+	err = n.Start()
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed regenerating network: %w", err))
+	}
 
-		return nil
+	// If this is a cluster notification, we're done.
+	if isClusterNotification(r) {
+		return response.EmptySyncResponse
 	}
 
-	loadAndInitNetwork := func(pn network.ProjectNetwork, priority int, firstPass bool) error {
-		var err error
-		var n network.Network
+	notifier, err := cluster.NewNotifier(s, s.Endpoints.NetworkCert(), s.ServerCert(), cluster.NotifyAll)
+	if err != nil {
+		return response.SmartError(err)
+	}
 
-		if firstPass && loadedNetworks[pn] != nil {
-			// Check if network already loaded from during first pass phase.
-			n = loadedNetworks[pn]
-		} else {
-			n, err = network.LoadByName(s, pn.ProjectName, pn.NetworkName)
-			if err != nil {
-				if api.StatusErrorCheck(err, http.StatusNotFound) {
-					// Network has been deleted since we began trying to start it so delete
-					// entry.
-					delete(initNetworks[priority], pn)
+	err = notifier(networkMemberNotify(s, context.Background(), func(_ context.Context, client incus.InstanceServer) error {
+		return client.UseProject(n.Project()).RegenerateNetwork(n.Name())
+	}))
+	if err != nil {
+		return response.SmartError(err)
+	}
 
-					return nil
-				}
+	return response.EmptySyncResponse
+}
 
-				return fmt.Errorf("Failed loading: %w", err)
-			}
-		}
+// swagger:operation POST /1.0/networks/{name}/recreate networks network_recreate_post
+//
+//	Recreate the network
+//
+//	Tears down the network's driver state (interfaces, dnsmasq, firewall rules, ...) and
+//	sets it up again from the stored config, on all cluster members.
+//	The database record and the network's forwards, load balancers and peers are kept.
+//	Networks which are in use can't be recreated.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    $ref: "#/responses/EmptySyncResponse"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkRecreatePost(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
 
-		netConfig := n.Config()
-		err = n.Validate(netConfig)
-		if err != nil {
-			return fmt.Errorf("Failed validating: %w", err)
-		}
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
 
-		// Update network start priority based on dependencies.
-		if netConfig["parent"] != "" && priority != networkPriorityPhysical {
-			// Start networks that depend on physical interfaces existing after
-			// non-dependent networks.
-			delete(initNetworks[priority], pn)
-			initNetworks[networkPriorityPhysical][pn] = struct{}{}
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
 
-			return nil
-		} else if netConfig["network"] != "" && priority != networkPriorityLogical {
-			// Start networks that depend on other logical networks after networks after
-			// non-dependent networks and networks that depend on physical interfaces.
-			delete(initNetworks[priority], pn)
-			initNetworks[networkPriorityLogical][pn] = struct{}{}
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
 
-			return nil
-		}
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
+	}
 
-		err = initNetwork(n, priority)
-		if err != nil {
-			return err
-		}
+	if n.Status() != api.NetworkStatusCreated {
+		return response.BadRequest(errors.New("Cannot recreate network when not in created state"))
+	}
 
-		return nil
+	err = n.Validate(n.Config())
+	if err != nil {
+		return response.BadRequest(fmt.Errorf("Stored network config is invalid: %w", err))
 	}
 
-	// Try initializing networks in priority order.
-	for priority := range initNetworks {
-		for pn := range initNetworks[priority] {
-			err := loadAndInitNetwork(pn, priority, true)
-			if err != nil {
-				logger.Error("Failed initializing network", logger.Ctx{"project": pn.ProjectName, "network": pn.NetworkName, "err": err})
+	// Stopping the network detaches whatever is connected to it, so only rebuild unused networks.
+	isUsed, err := n.IsUsed(false)
+	if err != nil {
+		return response.SmartError(err)
+	}
 
-				continue
-			}
-		}
+	if isUsed {
+		return response.BadRequest(errors.New("Cannot recreate a network that is in use"))
 	}
 
-	loadedNetworks = nil // Don't store loaded networks after first pass.
+	// Tear down the driver state, keeping the database record and the network's sub-resources.
+	err = n.Stop()
+	if err != nil {
+		// Bring back whatever was torn down before the failure.
+		_ = n.Start()
 
-	remainingNetworks := 0
-	for _, networks := range initNetworks {
-		remainingNetworks += len(networks)
+		return response.SmartError(fmt.Errorf("Failed stopping network: %w", err))
 	}
 
-	// For any remaining networks that were not successfully initialized, we now start a go routine to
-	// periodically try to initialize them again in the background.
-	if remainingNetworks > 0 {
-		go func() {
-			for {
-				t := time.NewTimer(time.Duration(time.Minute))
+	err = n.Start()
+	if err != nil {
+		err = fmt.Errorf("Failed recreating network: %w", err)
 
-				select {
-				case <-s.ShutdownCtx.Done():
-					t.Stop()
-					return
-				case <-t.C:
-					t.Stop()
+		// The driver leaves the network unavailable, record it the same way as a failure to start on startup.
+		_ = s.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+			return tx.UpsertWarningLocalNode(ctx, n.Project(), dbCluster.TypeNetwork, int(n.ID()), warningtype.NetworkUnvailable, err.Error())
+		})
 
-					tryInstancesStart := false
+		return response.SmartError(err)
+	}
 
-					// Try initializing networks in priority order.
-					for priority := range initNetworks {
-						for pn := range initNetworks[priority] {
-							err := loadAndInitNetwork(pn, priority, false)
-							if err != nil {
-								logger.Error("Failed initializing network", logger.Ctx{"project": pn.ProjectName, "network": pn.NetworkName, "err": err})
+	_ = warnings.ResolveWarningsByLocalNodeAndProjectAndTypeAndEntity(s.DB.Cluster, n.Project(), warningtype.NetworkUnvailable, dbCluster.TypeNetwork, int(n.ID()))
 
-								continue
-							}
+	// If this is a cluster notification, we're done.
+	if isClusterNotification(r) {
+		return response.EmptySyncResponse
+	}
 
-							tryInstancesStart = true // We initialized at least one network.
-						}
-					}
+	notifier, err := cluster.NewNotifier(s, s.Endpoints.NetworkCert(), s.ServerCert(), cluster.NotifyAll)
+	if err != nil {
+		return response.SmartError(err)
+	}
 
-					remainingNetworks := 0
-					for _, networks := range initNetworks {
-						remainingNetworks += len(networks)
-					}
+	err = notifier(networkMemberNotify(s, context.Background(), func(_ context.Context, client incus.InstanceServer) error {
+		return client.UseProject(n.Project()).RecreateNetwork(n.Name())
+	}))
+	if err != nil {
+		return response.SmartError(err)
+	}
 
-					if remainingNetworks <= 0 {
-						logger.Info("All networks initialized")
-					}
+	return response.EmptySyncResponse
+}
 
-					// At least one remaining network was initialized, check if any instances
-					// can now start.
-					if tryInstancesStart {
-						instances, err := instance.LoadNodeAll(s, instancetype.Any)
-						if err != nil {
-							logger.Warn("Failed loading instances to start", logger.Ctx{"err": err})
-						} else {
-							instancesStart(s, instances)
-						}
-					}
+// swagger:operation POST /1.0/networks/{name}/restart networks network_restart_post
+//
+//	Restart the network
+//
+//	Stops and starts the network on the cluster member handling the request, re-applying its
+//	local configuration. This is useful after external changes, such as an uplink interface flapping.
+//	OVN networks are re-applied without being stopped, other networks can't be restarted while in use by instances.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: query
+//	    name: target
+//	    description: Cluster member name
+//	    type: string
+//	    example: server01
+//	responses:
+//	  "202":
+//	    $ref: "#/responses/Operation"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkRestartPost(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
 
-					if remainingNetworks <= 0 {
-						return // Our job here is done.
-					}
-				}
-			}
-		}()
-	} else {
-		logger.Info("All networks initialized")
+	// If a target was specified, forward the request to the relevant node.
+	resp := forwardedResponseIfTargetIsRemote(s, r)
+	if resp != nil {
+		return resp
 	}
 
-	return nil
-}
-
-func networkShutdown(s *state.State) {
-	var err error
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
 
-	// Get a list of projects.
-	var projectNames []string
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
 
-	err = s.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-		projectNames, err = dbCluster.GetProjectNames(ctx, tx.Tx())
-		return err
-	})
+	n, err := network.LoadByName(s, projectName, networkName)
 	if err != nil {
-		logger.Error("Failed shutting down networks, couldn't load projects", logger.Ctx{"err": err})
-		return
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
 	}
 
-	for _, projectName := range projectNames {
-		var networks []string
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
+	}
 
-		err := s.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			// Get a list of managed networks.
-			networks, err = tx.GetNetworks(ctx, projectName)
+	if n.Status() != api.NetworkStatusCreated {
+		return response.BadRequest(errors.New("Cannot restart network when not in created state"))
+	}
 
-			return err
-		})
+	// Stopping the network detaches the instances connected to it, only OVN networks are restarted in place.
+	if n.Type() != "ovn" {
+		isUsed, err := n.IsUsed(true)
 		if err != nil {
-			logger.Error("Failed shutting down networks, couldn't load networks for project", logger.Ctx{"project": projectName, "err": err})
-			continue
+			return response.SmartError(err)
 		}
 
-		// Bring them all down.
-		for _, name := range networks {
-			n, err := network.LoadByName(s, projectName, name)
-			if err != nil {
-				logger.Error("Failed shutting down network, couldn't load network", logger.Ctx{"network": name, "project": projectName, "err": err})
-				continue
-			}
-
-			err = n.Stop()
-			if err != nil {
-				logger.Error("Failed to bring down network", logger.Ctx{"err": err, "project": projectName, "name": name})
-			}
+		if isUsed {
+			return response.BadRequest(errors.New("Cannot restart a network in use by instances"))
 		}
 	}
-}
-
-// networkRestartOVN is used to trigger a restart of all OVN networks.
-func networkRestartOVN(s *state.State) error {
-	logger.Infof("Restarting OVN networks")
-
-	// Get a list of projects.
-	var projectNames []string
-	var err error
-	err = s.DB.Cluster.Transaction(s.ShutdownCtx, func(ctx context.Context, tx *db.ClusterTx) error {
-		projectNames, err = dbCluster.GetProjectNames(ctx, tx.Tx())
-		return err
-	})
-	if err != nil {
-		return fmt.Errorf("Failed to load projects: %w", err)
-	}
 
-	// Go over all the networks in every project.
-	for _, projectName := range projectNames {
-		var networkNames []string
+	run := func(op *operations.Operation) error {
+		// OVN networks are re-applied in place.
+		if n.Type() == "ovn" {
+			return networkRestartOVN(s, n.Project(), n.Name())
+		}
 
-		err := s.DB.Cluster.Transaction(s.ShutdownCtx, func(ctx context.Context, tx *db.ClusterTx) error {
-			networkNames, err = tx.GetCreatedNetworkNamesByProject(ctx, projectName)
+		err := n.Stop()
+		if err != nil {
+			return fmt.Errorf("Failed stopping network: %w", err)
+		}
 
-			return err
-		})
+		err = n.Start()
 		if err != nil {
-			return fmt.Errorf("Failed to load networks for project %q: %w", projectName, err)
+			return fmt.Errorf("Failed starting network: %w", err)
 		}
 
-		for _, networkName := range networkNames {
-			// Load the network struct.
-			n, err := network.LoadByName(s, projectName, networkName)
-			if err != nil {
-				return fmt.Errorf("Failed to load network %q in project %q: %w", networkName, projectName, err)
-			}
+		return nil
+	}
 
-			// Skip non-OVN networks.
-			if n.DBType() != db.NetworkTypeOVN {
-				continue
-			}
+	opResources := map[string][]api.URL{}
+	opResources["networks"] = []api.URL{*api.NewURL().Path(version.APIVersion, "networks", networkName).Project(projectName)}
 
-			// Restart the network.
-			err = n.Start()
-			if err != nil {
-				return fmt.Errorf("Failed to restart network %q in project %q: %w", networkName, projectName, err)
-			}
-		}
+	op, err := operations.OperationCreate(s, projectName, operations.OperationClassTask, operationtype.NetworkRestart, opResources, nil, run, nil, nil, r)
+	if err != nil {
+		return response.SmartError(err)
 	}
 
-	return nil
+	return operations.OperationResponse(op)
 }
 
-// swagger:operation GET /1.0/networks/{name}/state networks networks_state_get
+// swagger:operation POST /1.0/networks/{name}/validate networks network_validate_post
 //
-//	Get the network state
+//	Validate a network config
 //
-//	Returns the current network state information.
+//	Checks the proposed config against the network without applying it, reporting the failures of each
+//	config key so that they can be shown next to the relevant field.
 //
 //	---
+//	consumes:
+//	  - application/json
 //	produces:
 //	  - application/json
 //	parameters:
@@ -1919,9 +5504,15 @@ func networkRestartOVN(s *state.State) error {
 //	    description: Cluster member name
 //	    type: string
 //	    example: server01
+//	  - in: body
+//	    name: network
+//	    description: Proposed network configuration
+//	    required: true
+//	    schema:
+//	      $ref: "#/definitions/NetworkPut"
 //	responses:
 //	  "200":
-//	    description: API endpoints
+//	    description: Validation result
 //	    schema:
 //	      type: object
 //	      description: Sync response
@@ -1939,12 +5530,14 @@ func networkRestartOVN(s *state.State) error {
 //	          description: Status code
 //	          example: 200
 //	        metadata:
-//	          $ref: "#/definitions/NetworkState"
+//	          $ref: "#/definitions/NetworkConfigValidation"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
 //	  "403":
 //	    $ref: "#/responses/Forbidden"
 //	  "500":
 //	    $ref: "#/responses/InternalServerError"
-func networkStateGet(d *Daemon, r *http.Request) response.Response {
+func networkValidatePost(d *Daemon, r *http.Request) response.Response {
 	s := d.State()
 
 	// If a target was specified, forward the request to the relevant node.
@@ -1964,27 +5557,198 @@ func networkStateGet(d *Daemon, r *http.Request) response.Response {
 	}
 
 	n, err := network.LoadByName(s, projectName, networkName)
-	if err != nil && !api.StatusErrorCheck(err, http.StatusNotFound) {
+	if err != nil {
 		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
 	}
 
 	// Check if project allows access to network.
-	if !project.NetworkAllowed(reqProject.Config, networkName, n != nil && n.IsManaged()) {
-		return response.SmartError(api.StatusErrorf(http.StatusNotFound, "Network not found"))
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
 	}
 
-	var state *api.NetworkState
-	if n != nil {
-		state, err = n.State()
-		if err != nil {
-			return response.SmartError(err)
+	req := api.NetworkPut{}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	targetNode := request.QueryParam(r, "target")
+	keyErrs := map[string]string{}
+
+	// Member specific keys can only be validated when targeting a member, like when updating the network.
+	if targetNode == "" && s.ServerClustered {
+		for key := range req.Config {
+			if db.IsNodeSpecificNetworkConfig(key) {
+				keyErrs[key] = fmt.Sprintf("Config key %q is cluster member specific", key)
+				delete(req.Config, key)
+			}
 		}
-	} else {
-		state, err = resources.GetNetworkState(networkName)
+	}
+
+	config := networkUpdateConfig(n, req.Config, targetNode, http.MethodPut, s.ServerClustered)
+
+	// Expand the project variables referenced in the config, like when applying the update.
+	err = networkExpandConfig(r.Context(), s, n.Project(), config)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	return response.SyncResponse(true, networkValidateConfig(n, config, keyErrs))
+}
+
+// swagger:operation GET /1.0/networks/{name}/events networks network_events_get
+//
+//	Get the network history
+//
+//	Returns the recent lifecycle events (creation, updates, ...) of the network.
+//	When clustered, the events recorded by all cluster members are returned, oldest first.
+//
+//	When connecting using websocket, the network's creation, update, rename and deletion
+//	events are instead streamed as they happen.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    description: Network events (or websocket message when streaming)
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          type: array
+//	          description: List of events
+//	          items:
+//	            $ref: "#/definitions/Event"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "404":
+//	    $ref: "#/responses/NotFound"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkEventsGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
+	}
+
+	// Stream the network's lifecycle events as they happen when the client asks for a websocket.
+	if websocket.IsWebSocketUpgrade(r) {
+		return &networkEventsServe{req: r, s: s, projectName: n.Project(), networkName: n.Name()}
+	}
+
+	netEvents := networkHistory.get(n.Project(), n.Name())
+
+	// Lifecycle events are only recorded by the member that handled the request, so gather the others.
+	if s.ServerClustered && !isClusterNotification(r) {
+		memberEvents, memberErrs, err := networkMembersCollect(s, r, func(client incus.InstanceServer) ([]api.Event, error) {
+			return client.UseProject(n.Project()).GetNetworkEvents(n.Name())
+		})
 		if err != nil {
 			return response.SmartError(err)
 		}
+
+		for memberName, memberErr := range memberErrs {
+			logger.Warn("Failed getting network events from member", logger.Ctx{"member": memberName, "project": n.Project(), "network": n.Name(), "err": memberErr})
+		}
+
+		for _, result := range memberEvents {
+			netEvents = append(netEvents, result...)
+		}
+	}
+
+	// Members receiving each other's events record them too, only return each event once.
+	netEvents = networkEventsDedup(netEvents)
+
+	slices.SortStableFunc(netEvents, func(a api.Event, b api.Event) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+
+	return response.SyncResponse(true, netEvents)
+}
+
+type networkEventsServe struct {
+	req         *http.Request
+	s           *state.State
+	projectName string
+	networkName string
+}
+
+func (r *networkEventsServe) Render(w http.ResponseWriter) error {
+	return networkEventsSocket(r.s, r.req, w, r.projectName, r.networkName)
+}
+
+func (r *networkEventsServe) String() string {
+	return "network event handler"
+}
+
+// Code returns the HTTP code.
+func (r *networkEventsServe) Code() int {
+	return http.StatusOK
+}
+
+// networkEventsSocket streams the lifecycle events of a single network over websocket.
+// The events of the other cluster members are received through the local event server.
+func networkEventsSocket(s *state.State, r *http.Request, w http.ResponseWriter, projectName string, networkName string) error {
+	l := logger.AddContext(logger.Ctx{"remote": r.RemoteAddr, "project": projectName, "network": networkName})
+
+	conn, err := ws.Upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		l.Warn("Failed upgrading network event connection", logger.Ctx{"err": err})
+		return nil
+	}
+
+	defer func() { _ = conn.Close() }() // Ensure listener below ends when this function ends.
+
+	listenerConnection := &networkEventsListenerConnection{
+		EventListenerConnection: events.NewWebsocketListenerConnection(conn),
+		projectName:             projectName,
+		networkName:             networkName,
+	}
+
+	listener, err := s.Events.AddListener(projectName, false, nil, listenerConnection, []string{api.EventTypeLifecycle}, nil, nil, nil)
+	if err != nil {
+		l.Warn("Failed to add network event listener", logger.Ctx{"err": err})
+		return nil
 	}
 
-	return response.SyncResponse(true, state)
+	listener.Wait(r.Context())
+
+	return nil
 }
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	incus "github.com/lxc/incus/v6/client"
+	"github.com/lxc/incus/v6/internal/server/auth"
+	"github.com/lxc/incus/v6/internal/server/network"
+	"github.com/lxc/incus/v6/internal/server/project"
+	"github.com/lxc/incus/v6/internal/server/request"
+	"github.com/lxc/incus/v6/internal/server/response"
+	"github.com/lxc/incus/v6/internal/server/state"
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+var networkConsistencyCmd = APIEndpoint{
+	Path: "networks/{networkName}/consistency",
+
+	Get: APIEndpointAction{Handler: networkConsistencyGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+}
+
+// swagger:operation GET /1.0/networks/{name}/consistency networks network_consistency_get
+//
+//	Check the network consistency
+//
+//	Checks that the network's database record, authorizer entry and dataplane state
+//	(bridge interface, OVN logical router and switch, ...) agree with each other.
+//	For networks whose dataplane is local to each member, the dataplane is checked on all cluster members.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    description: Consistency report
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          $ref: "#/definitions/NetworkConsistency"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "404":
+//	    $ref: "#/responses/NotFound"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkConsistencyGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
+	}
+
+	if !n.IsManaged() {
+		return response.BadRequest(errors.New("Consistency checks are only available for managed networks"))
+	}
+
+	// Other members only report on their own dataplane.
+	if isClusterNotification(r) {
+		return response.SyncResponse(true, api.NetworkConsistency{
+			Dataplane: []api.NetworkConsistencyCheck{networkConsistencyDataplane(s, n)},
+		})
+	}
+
+	result := api.NetworkConsistency{
+		Database:   api.NetworkConsistencyCheck{Consistent: true},
+		Authorizer: api.NetworkConsistencyCheck{Consistent: true},
+		Dataplane:  []api.NetworkConsistencyCheck{networkConsistencyDataplane(s, n)},
+	}
+
+	// Check the database record.
+	if n.Status() != api.NetworkStatusCreated {
+		result.Database.Consistent = false
+		result.Database.Message = fmt.Sprintf("Network status is %q", n.Status())
+	}
+
+	// Check the authorizer entry.
+	found, err := s.Authorizer.HasNetwork(r.Context(), n.Project(), n.Name())
+	if err != nil {
+		result.Authorizer.Consistent = false
+		result.Authorizer.Message = err.Error()
+	} else if !found {
+		result.Authorizer.Consistent = false
+		result.Authorizer.Message = "Network missing from the authorizer"
+	}
+
+	// OVN networks have a single dataplane shared by all members, others need checking on each member.
+	if s.ServerClustered && n.Type() != "ovn" {
+		memberResults, memberErrs, err := networkMembersCollect(s, r, func(client incus.InstanceServer) (*api.NetworkConsistency, error) {
+			return client.UseProject(n.Project()).GetNetworkConsistency(n.Name())
+		})
+		if err != nil {
+			return response.SmartError(err)
+		}
+
+		for _, memberResult := range memberResults {
+			result.Dataplane = append(result.Dataplane, memberResult.Dataplane...)
+		}
+
+		for memberName, memberErr := range memberErrs {
+			result.Dataplane = append(result.Dataplane, api.NetworkConsistencyCheck{
+				Location:   memberName,
+				Consistent: false,
+				Message:    fmt.Sprintf("Failed checking member: %v", memberErr),
+			})
+		}
+
+		slices.SortFunc(result.Dataplane, func(a api.NetworkConsistencyCheck, b api.NetworkConsistencyCheck) int {
+			return strings.Compare(a.Location, b.Location)
+		})
+	}
+
+	result.Consistent = result.Database.Consistent && result.Authorizer.Consistent
+	for _, check := range result.Dataplane {
+		if !check.Consistent {
+			result.Consistent = false
+		}
+	}
+
+	return response.SyncResponse(true, result)
+}
+
+// networkConsistencyDataplane checks the network's dataplane on the local member against its database status.
+func networkConsistencyDataplane(s *state.State, n network.Network) api.NetworkConsistencyCheck {
+	check := api.NetworkConsistencyCheck{Consistent: true}
+	if s.ServerClustered {
+		check.Location = s.ServerName
+	}
+
+	if n.LocalStatus() != api.NetworkStatusCreated {
+		check.Consistent = false
+		check.Message = fmt.Sprintf("Network status on member is %q", n.LocalStatus())
+
+		return check
+	}
+
+	err := n.CheckDataplane()
+	if err != nil {
+		check.Consistent = false
+		check.Message = err.Error()
+	}
+
+	return check
+}
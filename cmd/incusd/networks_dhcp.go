@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	clusterRequest "github.com/lxc/incus/v6/internal/server/cluster/request"
+	"github.com/lxc/incus/v6/internal/server/db"
+	dbCluster "github.com/lxc/incus/v6/internal/server/db/cluster"
+	"github.com/lxc/incus/v6/internal/server/db/warningtype"
+	"github.com/lxc/incus/v6/internal/server/network"
+	"github.com/lxc/incus/v6/internal/server/state"
+	"github.com/lxc/incus/v6/internal/server/task"
+	"github.com/lxc/incus/v6/internal/server/warnings"
+	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/logger"
+)
+
+// networkDHCPPoolWarningThreshold is the utilization of a DHCP address pool above which a warning is raised.
+const networkDHCPPoolWarningThreshold = 0.9
+
+// checkNetworkDHCPPools raises a warning for the local networks having a nearly exhausted DHCP address pool and
+// resolves it for the others.
+func checkNetworkDHCPPools(ctx context.Context, s *state.State) error {
+	var projectNetworks map[string]map[int64]api.Network
+
+	err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+
+		projectNetworks, err = tx.GetCreatedNetworks(ctx)
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed loading networks: %w", err)
+	}
+
+	for projectName, networks := range projectNetworks {
+		for _, netInfo := range networks {
+			l := logger.AddContext(logger.Ctx{"project": projectName, "network": netInfo.Name})
+
+			n, err := network.LoadByName(s, projectName, netInfo.Name)
+			if err != nil {
+				l.Error("Failed loading network", logger.Ctx{"err": err})
+				continue
+			}
+
+			if n.LocalStatus() != api.NetworkStatusCreated {
+				continue
+			}
+
+			pools, err := networkDHCPPools(n, clusterRequest.ClientTypeNormal)
+			if err != nil {
+				l.Warn("Failed getting DHCP pool utilization", logger.Ctx{"err": err})
+				continue
+			}
+
+			exhausted := []string{}
+			for _, pool := range pools {
+				if pool.Total > 0 && float64(pool.Allocated) >= float64(pool.Total)*networkDHCPPoolWarningThreshold {
+					exhausted = append(exhausted, fmt.Sprintf("%s pool has %d of %d addresses allocated", pool.Family, pool.Allocated, pool.Total))
+				}
+			}
+
+			if len(exhausted) > 0 {
+				err = s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+					return tx.UpsertWarningLocalNode(ctx, n.Project(), dbCluster.TypeNetwork, int(n.ID()), warningtype.NetworkDHCPPoolNearlyExhausted, strings.Join(exhausted, ", "))
+				})
+			} else {
+				err = warnings.ResolveWarningsByLocalNodeAndProjectAndTypeAndEntity(s.DB.Cluster, n.Project(), warningtype.NetworkDHCPPoolNearlyExhausted, dbCluster.TypeNetwork, int(n.ID()))
+			}
+
+			if err != nil {
+				l.Warn("Failed updating DHCP pool warning", logger.Ctx{"err": err})
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkNetworkDHCPPoolsTask(d *Daemon) (task.Func, task.Schedule) {
+	f := func(ctx context.Context) {
+		err := checkNetworkDHCPPools(ctx, d.State())
+		if err != nil {
+			logger.Error("Failed checking network DHCP pools", logger.Ctx{"err": err})
+		}
+	}
+
+	return f, task.Every(5 * time.Minute)
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lxc/incus/v6/internal/server/events"
+	"github.com/lxc/incus/v6/internal/version"
+	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/logger"
+)
+
+// networkHistoryMaxEvents is the number of lifecycle events retained per network.
+const networkHistoryMaxEvents = 50
+
+// networkHistory keeps the recent lifecycle events of the networks managed through this member.
+var networkHistory = &networkEventHistory{events: map[string][]api.Event{}}
+
+// networkEventHistory is a bounded, per-network store of lifecycle events.
+// When a path is set, the history is saved to it on every change so it survives daemon restarts.
+type networkEventHistory struct {
+	mu     sync.Mutex
+	events map[string][]api.Event
+	path   string
+}
+
+// networkEventID returns the identity of an event, used to detect the same event being received twice
+// (for example both pushed by a member and pulled from it).
+func networkEventID(event api.Event) string {
+	return event.Location + "/" + event.Timestamp.UTC().Format(time.RFC3339Nano) + "/" + string(event.Metadata)
+}
+
+// networkEventsDedup returns the events without the duplicates, keeping the first occurrence.
+func networkEventsDedup(events []api.Event) []api.Event {
+	seen := make(map[string]bool, len(events))
+	result := make([]api.Event, 0, len(events))
+
+	for _, event := range events {
+		id := networkEventID(event)
+		if seen[id] {
+			continue
+		}
+
+		seen[id] = true
+		result = append(result, event)
+	}
+
+	return result
+}
+
+// load restores the history saved at path and saves all future changes to it.
+func (h *networkEventHistory) load(path string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.path = path
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+
+		return fmt.Errorf("Failed reading network history: %w", err)
+	}
+
+	events := map[string][]api.Event{}
+	err = json.Unmarshal(content, &events)
+	if err != nil {
+		return fmt.Errorf("Failed parsing network history: %w", err)
+	}
+
+	if events != nil {
+		h.events = events
+	}
+
+	return nil
+}
+
+// save writes the history to its path, it must be called with the lock held.
+func (h *networkEventHistory) save() {
+	if h.path == "" {
+		return
+	}
+
+	content, err := json.Marshal(h.events)
+	if err != nil {
+		logger.Warn("Failed encoding network history", logger.Ctx{"err": err})
+		return
+	}
+
+	// Write to a temporary file first so a crash can't leave a truncated history behind.
+	err = os.WriteFile(h.path+".tmp", content, 0o600)
+	if err == nil {
+		err = os.Rename(h.path+".tmp", h.path)
+	}
+
+	if err != nil {
+		logger.Warn("Failed saving network history", logger.Ctx{"path": h.path, "err": err})
+	}
+}
+
+// networkHistoryKey returns the history key for a network in a project.
+func networkHistoryKey(projectName string, networkName string) string {
+	if projectName == "" {
+		projectName = api.ProjectDefaultName
+	}
+
+	return projectName + "/" + networkName
+}
+
+// networkLifecycleEvent returns the lifecycle event and the name of the network it applies to.
+// Events for the sub-resources of networks (forwards, peers, ...) aren't considered.
+func networkLifecycleEvent(event api.Event) (*api.EventLifecycle, string, bool) {
+	if event.Type != api.EventTypeLifecycle {
+		return nil, "", false
+	}
+
+	var lifecycleEvent api.EventLifecycle
+	err := json.Unmarshal(event.Metadata, &lifecycleEvent)
+	if err != nil {
+		return nil, "", false
+	}
+
+	if !strings.HasPrefix(lifecycleEvent.Action, "network-") {
+		return nil, "", false
+	}
+
+	u, err := url.Parse(lifecycleEvent.Source)
+	if err != nil {
+		return nil, "", false
+	}
+
+	networkName, found := strings.CutPrefix(u.Path, "/"+version.APIVersion+"/networks/")
+	if !found || networkName == "" || strings.Contains(networkName, "/") {
+		return nil, "", false
+	}
+
+	return &lifecycleEvent, networkName, true
+}
+
+// handleEvent records network lifecycle events, it's meant to be registered with the internal event listener.
+func (h *networkEventHistory) handleEvent(event api.Event) {
+	lifecycleEvent, networkName, ok := networkLifecycleEvent(event)
+	if !ok {
+		return
+	}
+
+	key := networkHistoryKey(event.Project, networkName)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	defer h.save()
+
+	switch lifecycleEvent.Action {
+	case api.EventLifecycleNetworkDeleted:
+		delete(h.events, key)
+		return
+	case api.EventLifecycleNetworkRenamed:
+		oldName, ok := lifecycleEvent.Context["old_name"].(string)
+		if ok {
+			oldKey := networkHistoryKey(event.Project, oldName)
+			h.events[key] = h.events[oldKey]
+			delete(h.events, oldKey)
+		}
+	}
+
+	// Ignore events already recorded, they can be received more than once in a cluster.
+	id := networkEventID(event)
+	for _, recorded := range h.events[key] {
+		if networkEventID(recorded) == id {
+			return
+		}
+	}
+
+	events := append(h.events[key], event)
+	if len(events) > networkHistoryMaxEvents {
+		events = events[len(events)-networkHistoryMaxEvents:]
+	}
+
+	h.events[key] = events
+}
+
+// get returns a copy of the recorded events for a network.
+func (h *networkEventHistory) get(projectName string, networkName string) []api.Event {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	events := h.events[networkHistoryKey(projectName, networkName)]
+	result := make([]api.Event, len(events))
+	copy(result, events)
+
+	return result
+}
+
+// networkEventsStreamActions are the lifecycle actions streamed to the per-network event listeners.
+var networkEventsStreamActions = []string{api.EventLifecycleNetworkCreated, api.EventLifecycleNetworkUpdated, api.EventLifecycleNetworkDeleted, api.EventLifecycleNetworkRenamed}
+
+// networkEventsListenerConnection wraps an event listener connection to only deliver the lifecycle events
+// of a single network. Renames are followed so the listener keeps receiving the events of the network.
+type networkEventsListenerConnection struct {
+	events.EventListenerConnection
+
+	mu          sync.Mutex
+	projectName string
+	networkName string
+}
+
+// WriteJSON sends the event to the underlying connection if it applies to the watched network.
+func (c *networkEventsListenerConnection) WriteJSON(event any) error {
+	e, ok := event.(api.Event)
+	if !ok || e.Project != c.projectName {
+		return nil
+	}
+
+	lifecycleEvent, networkName, ok := networkLifecycleEvent(e)
+	if !ok || !slices.Contains(networkEventsStreamActions, lifecycleEvent.Action) {
+		return nil
+	}
+
+	c.mu.Lock()
+	if lifecycleEvent.Action == api.EventLifecycleNetworkRenamed {
+		oldName, _ := lifecycleEvent.Context["old_name"].(string)
+		if oldName != c.networkName {
+			c.mu.Unlock()
+			return nil
+		}
+
+		c.networkName = networkName
+	} else if networkName != c.networkName {
+		c.mu.Unlock()
+		return nil
+	}
+
+	c.mu.Unlock()
+
+	return c.EventListenerConnection.WriteJSON(event)
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+// networkHistoryTestEvent returns a lifecycle event for a network in the default project.
+func networkHistoryTestEvent(t *testing.T, action string, networkName string, timestamp time.Time, ctx map[string]any) api.Event {
+	t.Helper()
+
+	metadata, err := json.Marshal(api.EventLifecycle{
+		Action:  action,
+		Source:  "/1.0/networks/" + networkName,
+		Context: ctx,
+	})
+	require.NoError(t, err)
+
+	return api.Event{
+		Type:      api.EventTypeLifecycle,
+		Timestamp: timestamp,
+		Location:  "server01",
+		Project:   api.ProjectDefaultName,
+		Metadata:  metadata,
+	}
+}
+
+func TestNetworkEventHistory_Record(t *testing.T) {
+	h := &networkEventHistory{events: map[string][]api.Event{}}
+	now := time.Now()
+
+	created := networkHistoryTestEvent(t, api.EventLifecycleNetworkCreated, "foo", now, nil)
+	h.handleEvent(created)
+
+	// The same event received a second time (e.g. pushed and pulled) is only recorded once.
+	h.handleEvent(created)
+	assert.Len(t, h.get(api.ProjectDefaultName, "foo"), 1)
+
+	// Events of the network sub-resources aren't recorded.
+	forward := networkHistoryTestEvent(t, api.EventLifecycleNetworkForwardCreated, "foo/forwards/10.0.0.1", now, nil)
+	h.handleEvent(forward)
+	assert.Len(t, h.get(api.ProjectDefaultName, "foo"), 1)
+
+	// Renames carry the history over to the new name.
+	renamed := networkHistoryTestEvent(t, api.EventLifecycleNetworkRenamed, "bar", now.Add(time.Second), map[string]any{"old_name": "foo"})
+	h.handleEvent(renamed)
+	assert.Empty(t, h.get(api.ProjectDefaultName, "foo"))
+	assert.Len(t, h.get(api.ProjectDefaultName, "bar"), 2)
+
+	// Deleting the network drops its history.
+	deleted := networkHistoryTestEvent(t, api.EventLifecycleNetworkDeleted, "bar", now.Add(2*time.Second), nil)
+	h.handleEvent(deleted)
+	assert.Empty(t, h.get(api.ProjectDefaultName, "bar"))
+}
+
+func TestNetworkEventHistory_Limit(t *testing.T) {
+	h := &networkEventHistory{events: map[string][]api.Event{}}
+	now := time.Now()
+
+	for i := range networkHistoryMaxEvents + 10 {
+		h.handleEvent(networkHistoryTestEvent(t, api.EventLifecycleNetworkUpdated, "foo", now.Add(time.Duration(i)*time.Second), nil))
+	}
+
+	events := h.get(api.ProjectDefaultName, "foo")
+	require.Len(t, events, networkHistoryMaxEvents)
+	assert.True(t, events[0].Timestamp.Equal(now.Add(10*time.Second)))
+}
+
+func TestNetworkEventHistory_Persistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "network-history.json")
+	now := time.Now()
+
+	h := &networkEventHistory{events: map[string][]api.Event{}}
+	require.NoError(t, h.load(path))
+	h.handleEvent(networkHistoryTestEvent(t, api.EventLifecycleNetworkCreated, "foo", now, nil))
+
+	restored := &networkEventHistory{events: map[string][]api.Event{}}
+	require.NoError(t, restored.load(path))
+
+	events := restored.get(api.ProjectDefaultName, "foo")
+	require.Len(t, events, 1)
+	assert.True(t, events[0].Timestamp.Equal(now))
+}
+
+func TestNetworkEventsDedup(t *testing.T) {
+	now := time.Now()
+	first := networkHistoryTestEvent(t, api.EventLifecycleNetworkCreated, "foo", now, nil)
+	second := networkHistoryTestEvent(t, api.EventLifecycleNetworkUpdated, "foo", now, nil)
+
+	otherMember := first
+	otherMember.Location = "server02"
+
+	events := networkEventsDedup([]api.Event{first, second, first, otherMember, second})
+	assert.Equal(t, []api.Event{first, second, otherMember}, events)
+}
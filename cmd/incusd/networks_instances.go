@@ -0,0 +1,183 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/lxc/incus/v6/internal/server/auth"
+	clusterRequest "github.com/lxc/incus/v6/internal/server/cluster/request"
+	"github.com/lxc/incus/v6/internal/server/db"
+	dbCluster "github.com/lxc/incus/v6/internal/server/db/cluster"
+	"github.com/lxc/incus/v6/internal/server/network"
+	"github.com/lxc/incus/v6/internal/server/project"
+	"github.com/lxc/incus/v6/internal/server/request"
+	"github.com/lxc/incus/v6/internal/server/response"
+	"github.com/lxc/incus/v6/internal/version"
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+var networkInstancesCmd = APIEndpoint{
+	Path: "networks/{networkName}/instances",
+
+	Get: APIEndpointAction{Handler: networkInstancesGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanView, "networkName")},
+}
+
+// swagger:operation GET /1.0/networks/{name}/instances networks network_instances_get
+//
+//	Get the instances connected to the network
+//
+//	Returns the instance devices connected to the network along with their MAC address
+//	and the addresses assigned to them, either statically or through DHCP leases.
+//	Only the instances the caller is allowed to see are returned.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    description: API endpoints
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          type: array
+//	          description: List of instance devices
+//	          items:
+//	            $ref: "#/definitions/NetworkInstance"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "404":
+//	    $ref: "#/responses/NotFound"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkInstancesGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed loading network: %w", err))
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return response.SmartError(networkNotAllowedError(s, r, networkName))
+	}
+
+	// Get the addresses handed out by the network, keyed by MAC address.
+	leaseAddresses := map[string][]string{}
+	leases, err := n.Leases(reqProject.Name, clusterRequest.ClientTypeNormal)
+	if err != nil && !errors.Is(err, network.ErrNotImplemented) {
+		return response.SmartError(err)
+	}
+
+	for _, lease := range leases {
+		mac, err := net.ParseMAC(lease.Hwaddr)
+		if err != nil {
+			continue
+		}
+
+		leaseAddresses[mac.String()] = append(leaseAddresses[mac.String()], lease.Address)
+	}
+
+	// Get the instance devices using the network.
+	instances := []api.NetworkInstance{}
+	filter := dbCluster.InstanceFilter{Project: &reqProject.Name}
+	err = network.UsedByInstanceDevices(s, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
+		hwaddr := nicConfig["hwaddr"]
+		if hwaddr == "" {
+			hwaddr = inst.Config[fmt.Sprintf("volatile.%s.hwaddr", nicName)]
+		}
+
+		addresses := []string{}
+		for _, key := range []string{"ipv4.address", "ipv6.address"} {
+			if nicConfig[key] != "" {
+				addresses = append(addresses, nicConfig[key])
+			}
+		}
+
+		mac, err := net.ParseMAC(hwaddr)
+		if err == nil {
+			hwaddr = mac.String()
+
+			for _, address := range leaseAddresses[hwaddr] {
+				if !slices.Contains(addresses, address) {
+					addresses = append(addresses, address)
+				}
+			}
+		}
+
+		instances = append(instances, api.NetworkInstance{
+			Name:      inst.Name,
+			Project:   inst.Project,
+			Location:  inst.Node,
+			Device:    nicName,
+			Hwaddr:    hwaddr,
+			Addresses: addresses,
+		})
+
+		return nil
+	}, filter)
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed getting instances using the network: %w", err))
+	}
+
+	// Only return the instances the caller is allowed to see.
+	allowed := map[string]bool{}
+	urls := make([]string, 0, len(instances))
+	for _, inst := range instances {
+		urls = append(urls, api.NewURL().Path(version.APIVersion, "instances", inst.Name).Project(inst.Project).String())
+	}
+
+	for _, u := range project.FilterUsedBy(s.Authorizer, r, urls) {
+		allowed[u] = true
+	}
+
+	filtered := make([]api.NetworkInstance, 0, len(instances))
+	for i, inst := range instances {
+		if allowed[urls[i]] {
+			filtered = append(filtered, inst)
+		}
+	}
+
+	slices.SortFunc(filtered, func(a api.NetworkInstance, b api.NetworkInstance) int {
+		return strings.Compare(a.Project+"/"+a.Name+"/"+a.Device, b.Project+"/"+b.Name+"/"+b.Device)
+	})
+
+	return response.SyncResponse(true, filtered)
+}
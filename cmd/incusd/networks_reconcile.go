@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/lxc/incus/v6/internal/server/db"
+	dbCluster "github.com/lxc/incus/v6/internal/server/db/cluster"
+	"github.com/lxc/incus/v6/internal/server/db/warningtype"
+	"github.com/lxc/incus/v6/internal/server/network"
+	"github.com/lxc/incus/v6/internal/server/state"
+	"github.com/lxc/incus/v6/internal/server/task"
+	"github.com/lxc/incus/v6/internal/server/warnings"
+	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/logger"
+)
+
+// reconcileNetworks checks the local dataplane of all the created networks against their configuration.
+// Drifted networks get a warning raised and, when repair is enabled, have their configuration re-applied.
+func reconcileNetworks(ctx context.Context, s *state.State, repair bool) error {
+	var projectNetworks map[string]map[int64]api.Network
+
+	err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+
+		projectNetworks, err = tx.GetCreatedNetworks(ctx)
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed loading networks: %w", err)
+	}
+
+	for projectName, networks := range projectNetworks {
+		for _, netInfo := range networks {
+			l := logger.AddContext(logger.Ctx{"project": projectName, "network": netInfo.Name})
+
+			n, err := network.LoadByName(s, projectName, netInfo.Name)
+			if err != nil {
+				l.Error("Failed loading network", logger.Ctx{"err": err})
+				continue
+			}
+
+			// Networks which failed to start are handled by the network startup retries.
+			if n.LocalStatus() != api.NetworkStatusCreated {
+				continue
+			}
+
+			driftErr := n.CheckDataplane()
+			if driftErr != nil && repair {
+				l.Warn("Network dataplane drifted from its configuration, re-applying", logger.Ctx{"err": driftErr})
+
+				err = n.Start()
+				if err != nil {
+					l.Error("Failed re-applying network configuration", logger.Ctx{"err": err})
+				} else {
+					driftErr = n.CheckDataplane()
+				}
+			}
+
+			if driftErr != nil {
+				l.Warn("Network dataplane drifted from its configuration", logger.Ctx{"err": driftErr})
+
+				_ = s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+					return tx.UpsertWarningLocalNode(ctx, n.Project(), dbCluster.TypeNetwork, int(n.ID()), warningtype.NetworkDrift, driftErr.Error())
+				})
+
+				continue
+			}
+
+			_ = warnings.ResolveWarningsByLocalNodeAndProjectAndTypeAndEntity(s.DB.Cluster, n.Project(), warningtype.NetworkDrift, dbCluster.TypeNetwork, int(n.ID()))
+		}
+	}
+
+	return nil
+}
+
+func reconcileNetworksTask(d *Daemon) (task.Func, task.Schedule) {
+	f := func(ctx context.Context) {
+		s := d.State()
+
+		// Check if we're supposed to reconcile at all (0 disables it).
+		interval := s.GlobalConfig.NetworkReconcileInterval()
+		if interval <= 0 {
+			return
+		}
+
+		elapsedMinutes := int64(math.Round(time.Since(s.StartTime).Minutes()))
+		if elapsedMinutes == 0 || elapsedMinutes%interval != 0 {
+			return
+		}
+
+		err := reconcileNetworks(ctx, s, s.GlobalConfig.NetworkReconcileMode() == "repair")
+		if err != nil {
+			logger.Error("Failed reconciling networks", logger.Ctx{"err": err})
+		}
+	}
+
+	return f, task.Every(time.Minute)
+}
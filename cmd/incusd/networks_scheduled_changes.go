@@ -0,0 +1,284 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/lxc/incus/v6/internal/server/auth"
+	"github.com/lxc/incus/v6/internal/server/cluster"
+	clusterRequest "github.com/lxc/incus/v6/internal/server/cluster/request"
+	"github.com/lxc/incus/v6/internal/server/db"
+	"github.com/lxc/incus/v6/internal/server/lifecycle"
+	"github.com/lxc/incus/v6/internal/server/network"
+	"github.com/lxc/incus/v6/internal/server/project"
+	"github.com/lxc/incus/v6/internal/server/request"
+	"github.com/lxc/incus/v6/internal/server/response"
+	"github.com/lxc/incus/v6/internal/server/state"
+	"github.com/lxc/incus/v6/internal/server/task"
+	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/logger"
+)
+
+var networkScheduledChangeCmd = APIEndpoint{
+	Path: "networks/{networkName}/scheduled-change",
+
+	Delete: APIEndpointAction{Handler: networkScheduledChangeDelete, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+	Get:    APIEndpointAction{Handler: networkScheduledChangeGet, AccessHandler: allowPermission(auth.ObjectTypeNetwork, auth.EntitlementCanEdit, "networkName")},
+}
+
+// networkScheduleUpdate validates the requested network update and stores it to be applied at the given time.
+func networkScheduleUpdate(s *state.State, r *http.Request, n network.Network, req api.NetworkPut, targetNode string, applyAtStr string) response.Response {
+	if targetNode != "" {
+		return response.BadRequest(errors.New("Member specific config changes can't be scheduled"))
+	}
+
+	applyAt, err := time.Parse(time.RFC3339, applyAtStr)
+	if err != nil {
+		return response.BadRequest(fmt.Errorf("Invalid apply-at value: %w", err))
+	}
+
+	if !applyAt.After(time.Now()) {
+		return response.BadRequest(errors.New("Scheduled changes must be applied in the future"))
+	}
+
+	// Validate the change against the current config, it is validated again when applied.
+	reqConfig := make(map[string]string, len(req.Config))
+	for k, v := range req.Config {
+		reqConfig[k] = v
+	}
+
+	newConfig := networkUpdateConfig(n, reqConfig, targetNode, r.Method, s.ServerClustered)
+
+	err = networkExpandConfig(r.Context(), s, n.Project(), newConfig)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	err = n.Validate(newConfig)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		return tx.CreateNetworkScheduledChange(ctx, n.ID(), applyAt, r.Method, req.Description, req.Config)
+	})
+	if err != nil {
+		return response.SmartError(fmt.Errorf("Failed storing scheduled change: %w", err))
+	}
+
+	return response.EmptySyncResponse
+}
+
+// swagger:operation GET /1.0/networks/{name}/scheduled-change networks network_scheduled_change_get
+//
+//	Get the scheduled change
+//
+//	Returns the config change scheduled to be applied to the network.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    description: Scheduled change
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          $ref: "#/definitions/NetworkScheduledChange"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "404":
+//	    $ref: "#/responses/NotFound"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkScheduledChangeGet(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	n, err := networkScheduledChangeLoad(s, r)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	var change *db.NetworkScheduledChange
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		change, err = tx.GetNetworkScheduledChange(ctx, n.ID())
+
+		return err
+	})
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	return response.SyncResponse(true, change.ToAPI())
+}
+
+// swagger:operation DELETE /1.0/networks/{name}/scheduled-change networks network_scheduled_change_delete
+//
+//	Cancel the scheduled change
+//
+//	Cancels the config change scheduled to be applied to the network.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	responses:
+//	  "200":
+//	    $ref: "#/responses/EmptySyncResponse"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "404":
+//	    $ref: "#/responses/NotFound"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func networkScheduledChangeDelete(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	n, err := networkScheduledChangeLoad(s, r)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		return tx.DeleteNetworkScheduledChange(ctx, n.ID())
+	})
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	return response.EmptySyncResponse
+}
+
+// networkScheduledChangeLoad loads the managed network targeted by the request.
+func networkScheduledChangeLoad(s *state.State, r *http.Request) (network.Network, error) {
+	projectName, reqProject, err := project.NetworkProject(s.DB.Cluster, request.ProjectParam(r))
+	if err != nil {
+		return nil, err
+	}
+
+	networkName, err := url.PathUnescape(mux.Vars(r)["networkName"])
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := network.LoadByName(s, projectName, networkName)
+	if err != nil {
+		return nil, fmt.Errorf("Failed loading network: %w", err)
+	}
+
+	// Check if project allows access to network.
+	if !project.NetworkAllowed(reqProject.Config, networkName, n.IsManaged()) {
+		return nil, networkNotAllowedError(s, r, networkName)
+	}
+
+	return n, nil
+}
+
+// applyNetworkScheduledChanges applies the scheduled network config changes which are due.
+func applyNetworkScheduledChanges(ctx context.Context, s *state.State) error {
+	var changes []db.NetworkScheduledChange
+	err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+		changes, err = tx.GetDueNetworkScheduledChanges(ctx, time.Now())
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed loading scheduled network changes: %w", err)
+	}
+
+	for _, change := range changes {
+		// The change is removed whatever the outcome so that a failing change isn't retried forever.
+		err = s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+			return tx.DeleteNetworkScheduledChange(ctx, change.NetworkID)
+		})
+		if err != nil {
+			return fmt.Errorf("Failed removing scheduled change of network %q in project %q: %w", change.Network, change.Project, err)
+		}
+
+		l := logger.AddContext(logger.Ctx{"project": change.Project, "network": change.Network, "applyAt": change.ApplyAt})
+
+		n, err := network.LoadByName(s, change.Project, change.Network)
+		if err != nil {
+			l.Error("Failed loading network for scheduled change", logger.Ctx{"err": err})
+			continue
+		}
+
+		if n.Status() != api.NetworkStatusCreated {
+			l.Error("Skipping scheduled change of network not in created state")
+			continue
+		}
+
+		req := api.NetworkPut{
+			Config:      change.Config,
+			Description: change.Description,
+		}
+
+		// Apply the change the same way as a direct update (will also notify other cluster members if needed).
+		err = doNetworkUpdate(s, n, req, "", clusterRequest.ClientTypeNormal, change.Method, s.ServerClustered, false, false)
+		if err != nil {
+			l.Error("Failed applying scheduled network change", logger.Ctx{"err": err})
+			continue
+		}
+
+		l.Info("Applied scheduled network change")
+		s.Events.SendLifecycle(n.Project(), lifecycle.NetworkUpdated.Event(n, nil, map[string]any{"scheduled": true}))
+	}
+
+	return nil
+}
+
+func applyNetworkScheduledChangesTask(d *Daemon) (task.Func, task.Schedule) {
+	f := func(ctx context.Context) {
+		s := d.State()
+
+		// When clustered, only the leader applies the scheduled changes.
+		leader, err := s.Cluster.LeaderAddress()
+		if err != nil && !errors.Is(err, cluster.ErrNodeIsNotClustered) {
+			logger.Error("Failed to get leader cluster member address", logger.Ctx{"err": err})
+			return
+		}
+
+		if err == nil && s.LocalConfig.ClusterAddress() != leader {
+			return
+		}
+
+		err = applyNetworkScheduledChanges(ctx, s)
+		if err != nil {
+			logger.Error("Failed applying scheduled network changes", logger.Ctx{"err": err})
+		}
+	}
+
+	return f, task.Every(time.Minute)
+}
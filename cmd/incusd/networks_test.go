@@ -0,0 +1,440 @@
+package main
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lxc/incus/v6/internal/filter"
+	"github.com/lxc/incus/v6/internal/server/network"
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+func TestNetworkAnnotations(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   map[string]string
+		expected map[string]string
+	}{
+		{
+			"No comments",
+			map[string]string{"ipv4.address": "10.0.0.1/24", "user.foo": "bar"},
+			nil,
+		},
+		{
+			"Comments",
+			map[string]string{
+				"ipv4.address":              "10.0.0.1/24",
+				"user.comment.ipv4.address": "Matches the old DHCP server",
+				"user.comment.ipv6.address": "Disabled until the uplink supports it",
+			},
+			map[string]string{
+				"ipv4.address": "Matches the old DHCP server",
+				"ipv6.address": "Disabled until the uplink supports it",
+			},
+		},
+		{
+			"Empty annotated key",
+			map[string]string{"user.comment.": "Not a comment"},
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, networkAnnotations(tt.config))
+		})
+	}
+}
+
+func TestNetworkSubnetConflicts(t *testing.T) {
+	projectNetworks := map[string]map[int64]api.Network{
+		"default": {
+			1: {Name: "br0", NetworkPut: api.NetworkPut{Config: map[string]string{"ipv4.address": "10.0.0.1/24", "ipv6.address": "none"}}},
+			2: {Name: "br1", NetworkPut: api.NetworkPut{Config: map[string]string{"ipv4.address": "auto", "ipv4.routes": "192.0.2.0/26, 192.0.2.128/26"}}},
+		},
+		"foo": {
+			3: {Name: "br0", NetworkPut: api.NetworkPut{Config: map[string]string{"ipv4.address": "10.0.0.129/25", "ipv6.address": "fd42::1/64"}}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		subnet   string
+		expected []api.NetworkSubnetConflict
+	}{
+		{
+			"No conflict",
+			"10.0.1.0/24",
+			[]api.NetworkSubnetConflict{},
+		},
+		{
+			"Addresses in several projects",
+			"10.0.0.0/16",
+			[]api.NetworkSubnetConflict{
+				{Project: "default", Network: "br0", Key: "ipv4.address", Subnet: "10.0.0.0/24"},
+				{Project: "foo", Network: "br0", Key: "ipv4.address", Subnet: "10.0.0.128/25"},
+			},
+		},
+		{
+			"Route",
+			"192.0.2.160/27",
+			[]api.NetworkSubnetConflict{
+				{Project: "default", Network: "br1", Key: "ipv4.routes", Subnet: "192.0.2.128/26"},
+			},
+		},
+		{
+			"IPv6",
+			"fd42::/48",
+			[]api.NetworkSubnetConflict{
+				{Project: "foo", Network: "br0", Key: "ipv6.address", Subnet: "fd42::/64"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, subnet, err := net.ParseCIDR(tt.subnet)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, networkSubnetConflicts(projectNetworks, subnet))
+		})
+	}
+}
+
+func TestNetworksGroupByUplink(t *testing.T) {
+	uplink := api.Network{Name: "UPLINK", Type: "physical", Project: api.ProjectDefaultName}
+	bridge := api.Network{Name: "br0", Type: "bridge", Project: "foo"}
+	ovn1 := api.Network{Name: "ovn1", Type: "ovn", Project: "foo", NetworkPut: api.NetworkPut{Config: map[string]string{"network": "UPLINK"}}}
+	ovn2 := api.Network{Name: "ovn2", Type: "ovn", Project: "foo", NetworkPut: api.NetworkPut{Config: map[string]string{"network": "none"}}}
+	ovn3 := api.Network{Name: "ovn3", Type: "ovn", Project: "foo", NetworkPut: api.NetworkPut{Config: map[string]string{"network": "UPLINK2"}}}
+
+	tests := []struct {
+		name     string
+		networks []api.Network
+		expected []api.NetworkUplinkGroup
+	}{
+		{
+			"No networks",
+			[]api.Network{},
+			[]api.NetworkUplinkGroup{},
+		},
+		{
+			"Uplink without overlays",
+			[]api.Network{uplink, bridge},
+			[]api.NetworkUplinkGroup{
+				{Name: "UPLINK", Network: &uplink, Overlays: []api.Network{}},
+			},
+		},
+		{
+			"Overlays",
+			[]api.Network{ovn3, ovn2, ovn1, uplink},
+			[]api.NetworkUplinkGroup{
+				{Name: "", Overlays: []api.Network{ovn2}},
+				{Name: "UPLINK", Network: &uplink, Overlays: []api.Network{ovn1}},
+				{Name: "UPLINK2", Overlays: []api.Network{ovn3}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, networksGroupByUplink(tt.networks))
+		})
+	}
+}
+
+func TestNetworkAddressFamilies(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   map[string]string
+		expected []string
+	}{
+		{
+			"No addresses",
+			map[string]string{},
+			[]string{},
+		},
+		{
+			"Disabled addresses",
+			map[string]string{"ipv4.address": "none", "ipv6.address": "none"},
+			[]string{},
+		},
+		{
+			"Dual stack",
+			map[string]string{"ipv4.address": "10.0.0.1/24", "ipv6.address": "fd42::1/64"},
+			[]string{"ipv4", "ipv6"},
+		},
+		{
+			"IPv6 only",
+			map[string]string{"ipv4.address": "none", "ipv6.address": "fd42::1/64"},
+			[]string{"ipv6"},
+		},
+		{
+			"Unallocated address",
+			map[string]string{"ipv4.address": "auto", "ipv6.address": "fd42::1/64"},
+			[]string{"ipv6"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, networkAddressFamilies(tt.config))
+		})
+	}
+}
+
+func TestNetworkFilterObject(t *testing.T) {
+	unused := api.Network{Name: "br0", Type: "bridge", NetworkPut: api.NetworkPut{Config: map[string]string{"ipv4.address": "10.0.0.1/24"}}}
+	used := api.Network{Name: "br1", Type: "bridge", UsedBy: []string{"/1.0/instances/c1"}}
+
+	tests := []struct {
+		filter   string
+		expected []string
+	}{
+		{"used eq true", []string{"br1"}},
+		{"used eq false", []string{"br0"}},
+		{"name eq br0 and used eq false", []string{"br0"}},
+		{"config.ipv4.address eq 10.0.0.1/24", []string{"br0"}},
+		{"type eq bridge", []string{"br0", "br1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			clauses, err := filter.Parse(tt.filter, filter.QueryOperatorSet())
+			require.NoError(t, err)
+
+			matches := []string{}
+			for _, netInfo := range []api.Network{unused, used} {
+				match, err := filter.Match(networkFilterObject(netInfo), *clauses)
+				require.NoError(t, err)
+
+				if match {
+					matches = append(matches, netInfo.Name)
+				}
+			}
+
+			assert.Equal(t, tt.expected, matches)
+		})
+	}
+}
+
+func TestNetworkConfigChangedKeys(t *testing.T) {
+	tests := []struct {
+		name      string
+		oldConfig map[string]string
+		newConfig map[string]string
+		expected  []string
+	}{
+		{
+			"Unchanged",
+			map[string]string{"ipv4.address": "10.0.0.1/24", "ipv4.nat": "true"},
+			map[string]string{"ipv4.address": "10.0.0.1/24", "ipv4.nat": "true"},
+			[]string{},
+		},
+		{
+			"Added, changed and removed",
+			map[string]string{"ipv4.address": "10.0.0.1/24", "ipv4.nat": "true", "dns.domain": "incus"},
+			map[string]string{"ipv4.address": "10.0.1.1/24", "ipv4.nat": "true", "ipv6.address": "none"},
+			[]string{"dns.domain", "ipv4.address", "ipv6.address"},
+		},
+		{
+			"Emptied value",
+			map[string]string{"ipv4.nat": "true"},
+			map[string]string{"ipv4.nat": ""},
+			[]string{"ipv4.nat"},
+		},
+		{
+			"From nothing",
+			nil,
+			map[string]string{"ipv4.nat": "true"},
+			[]string{"ipv4.nat"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, networkConfigChangedKeys(tt.oldConfig, tt.newConfig))
+		})
+	}
+}
+
+func TestNetworkExpandConfigValues(t *testing.T) {
+	projectConfig := map[string]string{"user.index": "12", "user.domain": "tenant.example.net", "limits.networks": "5"}
+
+	tests := []struct {
+		name     string
+		config   map[string]string
+		expected map[string]string
+		err      string
+	}{
+		{
+			"No variables",
+			map[string]string{"ipv4.address": "10.0.0.1/24"},
+			map[string]string{"ipv4.address": "10.0.0.1/24"},
+			"",
+		},
+		{
+			"Project variables",
+			map[string]string{"ipv4.address": "10.${project.index}.0.1/24", "dns.domain": "${project.name}.${project.domain}"},
+			map[string]string{"ipv4.address": "10.12.0.1/24", "dns.domain": "foo.tenant.example.net"},
+			"",
+		},
+		{
+			"User keys aren't expanded",
+			map[string]string{"user.template": "${project.index}", "user.other": "${unknown}"},
+			map[string]string{"user.template": "${project.index}", "user.other": "${unknown}"},
+			"",
+		},
+		{
+			"Only user project keys",
+			map[string]string{"bridge.mtu": "${project.limits.networks}"},
+			nil,
+			`Unresolved variable "project.limits.networks" in network config key "bridge.mtu"`,
+		},
+		{
+			"Unknown variable",
+			map[string]string{"dns.domain": "${instance.name}"},
+			nil,
+			`Unresolved variable "instance.name" in network config key "dns.domain"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := networkExpandConfigValues("foo", projectConfig, tt.config)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, tt.config)
+		})
+	}
+}
+
+func TestNetworkStartupDependencies(t *testing.T) {
+	tests := []struct {
+		name        string
+		projectName string
+		networkName string
+		config      map[string]string
+		expected    []network.ProjectNetwork
+	}{
+		{
+			"No dependencies",
+			"default",
+			"br0",
+			map[string]string{"ipv4.address": "10.0.0.1/24"},
+			nil,
+		},
+		{
+			"Uplink",
+			"foo",
+			"ovn0",
+			map[string]string{"network": "UPLINK"},
+			[]network.ProjectNetwork{{ProjectName: "default", NetworkName: "UPLINK"}},
+		},
+		{
+			"No uplink",
+			"foo",
+			"ovn0",
+			map[string]string{"network": "none"},
+			nil,
+		},
+		{
+			"Parent",
+			"default",
+			"UPLINK",
+			map[string]string{"parent": "br0"},
+			[]network.ProjectNetwork{{ProjectName: "default", NetworkName: "br0"}},
+		},
+		{
+			"Own parent",
+			"default",
+			"eth0",
+			map[string]string{"parent": "eth0"},
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, networkStartupDependencies(tt.projectName, tt.networkName, tt.config))
+		})
+	}
+}
+
+func TestNetworkStartupOrder(t *testing.T) {
+	uplink := network.ProjectNetwork{ProjectName: "default", NetworkName: "UPLINK"}
+	br0 := network.ProjectNetwork{ProjectName: "default", NetworkName: "br0"}
+	ovn0 := network.ProjectNetwork{ProjectName: "foo", NetworkName: "ovn0"}
+	ovn1 := network.ProjectNetwork{ProjectName: "foo", NetworkName: "ovn1"}
+	loop0 := network.ProjectNetwork{ProjectName: "default", NetworkName: "loop0"}
+	loop1 := network.ProjectNetwork{ProjectName: "default", NetworkName: "loop1"}
+
+	tests := []struct {
+		name           string
+		pending        []network.ProjectNetwork
+		priorities     map[network.ProjectNetwork]int64
+		dependencies   map[network.ProjectNetwork][]network.ProjectNetwork
+		expected       []network.ProjectNetwork
+		expectedCycles map[network.ProjectNetwork]bool
+	}{
+		{
+			"By name",
+			[]network.ProjectNetwork{ovn0, br0, uplink},
+			nil,
+			nil,
+			[]network.ProjectNetwork{uplink, br0, ovn0},
+			map[network.ProjectNetwork]bool{},
+		},
+		{
+			"By priority",
+			[]network.ProjectNetwork{ovn0, br0, uplink},
+			map[network.ProjectNetwork]int64{ovn0: 10, br0: 5},
+			nil,
+			[]network.ProjectNetwork{ovn0, br0, uplink},
+			map[network.ProjectNetwork]bool{},
+		},
+		{
+			"Dependencies first",
+			[]network.ProjectNetwork{ovn0, ovn1, br0, uplink},
+			map[network.ProjectNetwork]int64{ovn0: 10, ovn1: 10},
+			map[network.ProjectNetwork][]network.ProjectNetwork{ovn0: {uplink}, ovn1: {uplink}, uplink: {br0}},
+			[]network.ProjectNetwork{br0, uplink, ovn0, ovn1},
+			map[network.ProjectNetwork]bool{},
+		},
+		{
+			"Dependency already running",
+			[]network.ProjectNetwork{ovn0, br0},
+			nil,
+			map[network.ProjectNetwork][]network.ProjectNetwork{ovn0: {uplink}},
+			[]network.ProjectNetwork{br0, ovn0},
+			map[network.ProjectNetwork]bool{},
+		},
+		{
+			"Cycle",
+			[]network.ProjectNetwork{loop1, loop0, ovn0, uplink},
+			nil,
+			map[network.ProjectNetwork][]network.ProjectNetwork{loop0: {loop1}, loop1: {loop0}, ovn0: {uplink}},
+			[]network.ProjectNetwork{uplink, ovn0, loop0, loop1},
+			map[network.ProjectNetwork]bool{loop0: true, loop1: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pending := map[network.ProjectNetwork]struct{}{}
+			for _, pn := range tt.pending {
+				pending[pn] = struct{}{}
+			}
+
+			sorted, cycles := networkStartupOrder(pending, tt.priorities, tt.dependencies)
+			assert.Equal(t, tt.expected, sorted)
+			assert.Equal(t, tt.expectedCycles, cycles)
+		})
+	}
+}
@@ -1,16 +1,36 @@
 package main
 
 import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"net/http"
+	"regexp"
 	"slices"
+	"strings"
+	"sync"
+	"time"
 
+	incus "github.com/lxc/incus/v6/client"
+	internalInstance "github.com/lxc/incus/v6/internal/instance"
 	"github.com/lxc/incus/v6/internal/server/cluster"
 	"github.com/lxc/incus/v6/internal/server/db"
+	dbCluster "github.com/lxc/incus/v6/internal/server/db/cluster"
+	"github.com/lxc/incus/v6/internal/server/network"
 	"github.com/lxc/incus/v6/internal/server/state"
+	"github.com/lxc/incus/v6/shared/api"
 	"github.com/lxc/incus/v6/shared/logger"
+	"github.com/lxc/incus/v6/shared/util"
 )
 
 var networkOVNChassis *bool
 
+// networkMembersMaxConcurrency is the maximum number of cluster members queried at once by networkMembersCollect.
+const networkMembersMaxConcurrency = 8
+
 // networkUpdateOVNChassis gets called on heartbeats to check if OVN needs reconfiguring.
 func networkUpdateOVNChassis(s *state.State, heartbeatData *cluster.APIHeartbeat, localAddress string) error {
 	// Check if we have at least one active OVN chassis.
@@ -29,7 +49,7 @@ func networkUpdateOVNChassis(s *state.State, heartbeatData *cluster.APIHeartbeat
 	runChassis := !hasOVNChassis || localOVNChassis
 	if networkOVNChassis != nil && *networkOVNChassis != runChassis {
 		// Detected that the local OVN chassis setup may be incorrect, restarting.
-		err := networkRestartOVN(s)
+		err := networkRestartOVN(s, "", "")
 		if err != nil {
 			logger.Error("Error restarting OVN networks", logger.Ctx{"err": err})
 		}
@@ -38,3 +58,276 @@ func networkUpdateOVNChassis(s *state.State, heartbeatData *cluster.APIHeartbeat
 	networkOVNChassis = &runChassis
 	return nil
 }
+
+// networkMembersCollect runs the hook concurrently against every other cluster member and returns the results
+// and errors keyed by member name. Unlike the cluster notifier, a member that can't be reached doesn't fail the
+// whole call, it instead gets its error recorded so that callers can report partial results.
+func networkMembersCollect[T any](s *state.State, r *http.Request, hook func(client incus.InstanceServer) (T, error)) (map[string]T, map[string]error, error) {
+	var members []db.NodeInfo
+	err := s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+		members, err = tx.GetNodes(ctx)
+
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	localAddress := s.LocalConfig.ClusterAddress()
+	offlineThreshold := s.GlobalConfig.OfflineThreshold()
+
+	results := make(map[string]T, len(members))
+	errs := make(map[string]error)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, networkMembersMaxConcurrency)
+	for _, member := range members {
+		if member.Address == localAddress || member.Address == "0.0.0.0" {
+			continue // Exclude ourselves.
+		}
+
+		// Don't wait for the connection to time out on members known to be offline.
+		if member.IsOffline(offlineThreshold) {
+			mu.Lock()
+			errs[member.Name] = errors.New("Cluster member is offline")
+			mu.Unlock()
+
+			continue
+		}
+
+		wg.Add(1)
+		go func(member db.NodeInfo) {
+			defer wg.Done()
+
+			// Bound the number of concurrent requests on large clusters.
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			var result T
+			client, err := cluster.Connect(member.Address, s.Endpoints.NetworkCert(), s.ServerCert(), r, true)
+			if err == nil {
+				err = networkMemberNotify(s, context.Background(), func(ctx context.Context, client incus.InstanceServer) error {
+					result, err = hook(client)
+
+					return err
+				})(client)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs[member.Name] = err
+				return
+			}
+
+			results[member.Name] = result
+		}(member)
+	}
+
+	wg.Wait()
+
+	return results, errs, nil
+}
+
+// networkMemberNotify bounds the time each cluster member gets to handle the hook to the configured network member
+// timeout, so that an unresponsive member fails with a timeout error rather than blocking the whole request.
+// The hook gets the context of the member's deadline along with a client using it.
+func networkMemberNotify(s *state.State, ctx context.Context, hook func(ctx context.Context, client incus.InstanceServer) error) func(client incus.InstanceServer) error {
+	timeout := time.Duration(s.GlobalConfig.NetworkMemberTimeout()) * time.Second
+
+	return func(client incus.InstanceServer) error {
+		memberCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		err := hook(memberCtx, client.WithContext(memberCtx))
+		if err != nil && errors.Is(memberCtx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("Cluster member didn't handle the request within %s: %w", timeout, err)
+		}
+
+		return err
+	}
+}
+
+// networkMembersError combines the member errors returned by networkMembersCollect into a single error listing
+// the failed members, or returns nil if there are none.
+func networkMembersError(memberErrs map[string]error) error {
+	if len(memberErrs) == 0 {
+		return nil
+	}
+
+	errs := make([]error, 0, len(memberErrs))
+	for _, memberName := range slices.Sorted(maps.Keys(memberErrs)) {
+		errs = append(errs, fmt.Errorf("Cluster member %q: %w", memberName, memberErrs[memberName]))
+	}
+
+	return errors.Join(errs...)
+}
+
+// networkSubnetKeys are the config keys holding the subnets used by a network.
+var networkSubnetKeys = []string{"ipv4.address", "ipv4.routes", "ipv6.address", "ipv6.routes"}
+
+// networkSubnetConflicts returns the subnets of the supplied networks (indexed by project and ID) which overlap the
+// subnet, sorted by project, network and key. Special values such as "auto" or "none" are ignored.
+func networkSubnetConflicts(projectNetworks map[string]map[int64]api.Network, subnet *net.IPNet) []api.NetworkSubnetConflict {
+	conflicts := []api.NetworkSubnetConflict{}
+
+	for projectName, networks := range projectNetworks {
+		for _, netInfo := range networks {
+			for _, key := range networkSubnetKeys {
+				for _, value := range util.SplitNTrimSpace(netInfo.Config[key], ",", -1, true) {
+					_, netSubnet, err := net.ParseCIDR(value)
+					if err != nil {
+						continue
+					}
+
+					if !network.SubnetContains(subnet, netSubnet) && !network.SubnetContains(netSubnet, subnet) {
+						continue
+					}
+
+					conflicts = append(conflicts, api.NetworkSubnetConflict{
+						Project: projectName,
+						Network: netInfo.Name,
+						Key:     key,
+						Subnet:  netSubnet.String(),
+					})
+				}
+			}
+		}
+	}
+
+	slices.SortFunc(conflicts, func(a api.NetworkSubnetConflict, b api.NetworkSubnetConflict) int {
+		return cmp.Or(strings.Compare(a.Project, b.Project), strings.Compare(a.Network, b.Network), strings.Compare(a.Key, b.Key), strings.Compare(a.Subnet, b.Subnet))
+	})
+
+	return conflicts
+}
+
+// networkSubnetOverlaps returns, indexed by config key, the errors for the subnets in the address and routes keys
+// of the supplied config which overlap those of another managed network of the project.
+func networkSubnetOverlaps(ctx context.Context, s *state.State, projectName string, networkName string, config map[string]string) (map[string]error, error) {
+	var networks map[int64]api.Network
+	err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+		networks, err = tx.GetCreatedNetworksByProject(ctx, projectName)
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed loading project's networks for subnet overlap check: %w", err)
+	}
+
+	maps.DeleteFunc(networks, func(_ int64, netInfo api.Network) bool { return netInfo.Name == networkName })
+	projectNetworks := map[string]map[int64]api.Network{projectName: networks}
+
+	keyErrs := map[string]error{}
+	for _, key := range networkSubnetKeys {
+		for _, value := range util.SplitNTrimSpace(config[key], ",", -1, true) {
+			_, subnet, err := net.ParseCIDR(value)
+			if err != nil {
+				continue
+			}
+
+			conflicts := networkSubnetConflicts(projectNetworks, subnet)
+			if len(conflicts) > 0 {
+				keyErrs[key] = api.StatusErrorf(http.StatusBadRequest, "Subnet %s in %q overlaps subnet %s in %q of network %q (use allow-subnet-overlap to override)", subnet.String(), key, conflicts[0].Subnet, conflicts[0].Key, conflicts[0].Network)
+				break
+			}
+		}
+	}
+
+	return keyErrs, nil
+}
+
+// networkCheckSubnetOverlap returns an error listing the subnets of the supplied config which overlap those of
+// another managed network of the project.
+func networkCheckSubnetOverlap(ctx context.Context, s *state.State, projectName string, networkName string, config map[string]string) error {
+	keyErrs, err := networkSubnetOverlaps(ctx, s, projectName, networkName, config)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range networkSubnetKeys {
+		if keyErrs[key] != nil {
+			return keyErrs[key]
+		}
+	}
+
+	return nil
+}
+
+// networkConfigVariable matches the variables referenced in network config values, such as "${project.index}".
+var networkConfigVariable = regexp.MustCompile(`\$\{([^}]*)\}`)
+
+// networkExpandConfig expands the variables referenced in the supplied network config values, using the config
+// of the network's project. See networkExpandConfigValues for the supported variables.
+func networkExpandConfig(ctx context.Context, s *state.State, projectName string, config map[string]string) error {
+	hasVariables := false
+	for key, value := range config {
+		if !internalInstance.IsUserConfig(key) && strings.Contains(value, "${") {
+			hasVariables = true
+			break
+		}
+	}
+
+	if !hasVariables {
+		return nil
+	}
+
+	var projectConfig map[string]string
+	err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		p, err := dbCluster.GetProject(ctx, tx.Tx(), projectName)
+		if err != nil {
+			return err
+		}
+
+		projectConfig, err = dbCluster.GetProjectConfig(ctx, tx.Tx(), p.ID)
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed loading project %q for network config variables: %w", projectName, err)
+	}
+
+	return networkExpandConfigValues(projectName, projectConfig, config)
+}
+
+// networkExpandConfigValues expands the variables referenced in the supplied network config values.
+// "${project.name}" is the name of the project and "${project.KEY}" the value of the "user.KEY" key of the
+// project config. User keys are stored as-is. Unresolved variables result in an error.
+func networkExpandConfigValues(projectName string, projectConfig map[string]string, config map[string]string) error {
+	for _, key := range slices.Sorted(maps.Keys(config)) {
+		if internalInstance.IsUserConfig(key) {
+			continue
+		}
+
+		var expandErr error
+		config[key] = networkConfigVariable.ReplaceAllStringFunc(config[key], func(match string) string {
+			name := networkConfigVariable.FindStringSubmatch(match)[1]
+
+			projectKey, ok := strings.CutPrefix(name, "project.")
+			if ok && projectKey == "name" {
+				return projectName
+			}
+
+			value, found := projectConfig["user."+projectKey]
+			if !ok || !found {
+				if expandErr == nil {
+					expandErr = api.StatusErrorf(http.StatusBadRequest, "Unresolved variable %q in network config key %q", name, key)
+				}
+
+				return match
+			}
+
+			return value
+		})
+
+		if expandErr != nil {
+			return expandErr
+		}
+	}
+
+	return nil
+}
@@ -75,6 +75,7 @@ type Authorizer interface {
 	AddNetwork(ctx context.Context, projectName string, networkName string) error
 	DeleteNetwork(ctx context.Context, projectName string, networkName string) error
 	RenameNetwork(ctx context.Context, projectName string, oldNetworkName string, newNetworkName string) error
+	HasNetwork(ctx context.Context, projectName string, networkName string) (bool, error)
 
 	AddNetworkZone(ctx context.Context, projectName string, networkZoneName string) error
 	DeleteNetworkZone(ctx context.Context, projectName string, networkZoneName string) error
@@ -241,6 +241,11 @@ func (c *commonAuthorizer) RenameNetwork(ctx context.Context, projectName string
 	return nil
 }
 
+// HasNetwork always returns true as networks aren't tracked.
+func (c *commonAuthorizer) HasNetwork(ctx context.Context, projectName string, networkName string) (bool, error) {
+	return true, nil
+}
+
 // AddNetworkZone is a no-op.
 func (c *commonAuthorizer) AddNetworkZone(ctx context.Context, projectName string, networkZoneName string) error {
 	return nil
@@ -647,6 +647,28 @@ func (f *FGA) RenameNetwork(ctx context.Context, projectName string, oldNetworkN
 	return f.updateTuples(ctx, writes, deletions)
 }
 
+// HasNetwork checks whether the network is present in the authorizer.
+func (f *FGA) HasNetwork(ctx context.Context, projectName string, networkName string) (bool, error) {
+	f.onlineMu.Lock()
+	online := f.online
+	f.onlineMu.Unlock()
+
+	if !online {
+		return false, errors.New("The authorization server is currently offline")
+	}
+
+	resp, err := f.client.Check(ctx).Body(client.ClientCheckRequest{
+		User:     ObjectProject(projectName).String(),
+		Relation: relationProject,
+		Object:   ObjectNetwork(projectName, networkName).String(),
+	}).Execute()
+	if err != nil {
+		return false, fmt.Errorf("Failed to check OpenFGA relation for network %q in project %q: %w", networkName, projectName, err)
+	}
+
+	return resp.GetAllowed(), nil
+}
+
 // AddNetworkZone adds a network zone in the authorizer.
 func (f *FGA) AddNetworkZone(ctx context.Context, projectName string, networkZoneName string) error {
 	writes := []client.ClientTupleKey{
@@ -163,6 +163,36 @@ func (c *Config) NetworkOVNSSL() (string, string, string) {
 	return c.m.GetString("network.ovn.ca_cert"), c.m.GetString("network.ovn.client_cert"), c.m.GetString("network.ovn.client_key")
 }
 
+// NetworkCreateRetries returns the number of times the creation of a network on a cluster member is retried.
+func (c *Config) NetworkCreateRetries() int64 {
+	return c.m.GetInt64("network.create.retries")
+}
+
+// NetworkMemberTimeout returns the time in seconds allowed to each cluster member to handle a network change.
+func (c *Config) NetworkMemberTimeout() int64 {
+	return c.m.GetInt64("network.member_timeout")
+}
+
+// NetworkReconcileInterval returns the interval in minutes at which the network dataplanes are checked for drift.
+func (c *Config) NetworkReconcileInterval() int64 {
+	return c.m.GetInt64("network.reconcile.interval")
+}
+
+// NetworkReconcileMode returns what to do when a network dataplane drifted from its configuration.
+func (c *Config) NetworkReconcileMode() string {
+	return c.m.GetString("network.reconcile.mode")
+}
+
+// NetworkStartupRetryInterval returns the initial interval in seconds at which starting failed networks is retried.
+func (c *Config) NetworkStartupRetryInterval() int64 {
+	return c.m.GetInt64("network.startup.retry_interval")
+}
+
+// NetworkStartupRetryMaxInterval returns the maximum interval in seconds at which starting failed networks is retried.
+func (c *Config) NetworkStartupRetryMaxInterval() int64 {
+	return c.m.GetInt64("network.startup.retry_max_interval")
+}
+
 // LinstorControllerConnection returns the Linstor controller connection string.
 func (c *Config) LinstorControllerConnection() string {
 	return c.m.GetString("storage.linstor.controller_connection")
@@ -966,6 +996,28 @@ var ConfigSchema = config.Schema{
 
 	// OVN networking global keys.
 
+	// gendoc:generate(entity=server, group=miscellaneous, key=network.create.retries)
+	// Number of times the creation of a network on another cluster member is retried (with exponential backoff,
+	// up to 30 seconds between attempts) before the network creation is considered failed.
+	// Errors caused by the request itself aren't retried. The maximum is 10.
+	// ---
+	//  type: integer
+	//  scope: global
+	//  defaultdesc: `3`
+	//  shortdesc: Number of retries when creating a network on a cluster member
+	"network.create.retries": {Type: config.Int64, Default: "3", Validator: validate.Optional(validate.IsInRange(0, 10))},
+
+	// gendoc:generate(entity=server, group=miscellaneous, key=network.member_timeout)
+	// Specify the timeout in seconds.
+	// Cluster members which don't handle a network change (such as creating or deleting a network) within
+	// this time are considered failed rather than blocking the request.
+	// ---
+	//  type: integer
+	//  scope: global
+	//  defaultdesc: `120`
+	//  shortdesc: Time allowed to each cluster member to handle a network change
+	"network.member_timeout": {Type: config.Int64, Default: "120", Validator: validate.Optional(validate.IsInRange(1, 3600))},
+
 	// gendoc:generate(entity=server, group=miscellaneous, key=network.ovn.integration_bridge)
 	//
 	// ---
@@ -1011,6 +1063,48 @@ var ConfigSchema = config.Schema{
 	//  shortdesc: OVN SSL client key
 	"network.ovn.client_key": {Default: ""},
 
+	// gendoc:generate(entity=server, group=miscellaneous, key=network.reconcile.interval)
+	// Specify the interval in minutes.
+	// To disable checking the network dataplanes for drift, set this option to `0`.
+	// ---
+	//  type: integer
+	//  scope: global
+	//  defaultdesc: `0`
+	//  shortdesc: Interval at which to check the network dataplanes for drift
+	"network.reconcile.interval": {Type: config.Int64, Default: "0"},
+
+	// gendoc:generate(entity=server, group=miscellaneous, key=network.reconcile.mode)
+	// Possible values are `warn` and `repair`.
+	//
+	// If set to `warn`, a warning is raised for the networks whose dataplane drifted from their configuration.
+	// If set to `repair`, the network configuration is also re-applied to the dataplane.
+	// ---
+	//  type: string
+	//  scope: global
+	//  defaultdesc: `warn`
+	//  shortdesc: What to do when a network dataplane drifted from its configuration
+	"network.reconcile.mode": {Default: "warn", Validator: validate.Optional(validate.IsOneOf("warn", "repair"))},
+
+	// gendoc:generate(entity=server, group=miscellaneous, key=network.startup.retry_interval)
+	// Specify the interval in seconds.
+	// Networks which failed to start are retried in the background, doubling the interval after each
+	// unsuccessful attempt up to `network.startup.retry_max_interval`.
+	// ---
+	//  type: integer
+	//  scope: global
+	//  defaultdesc: `60`
+	//  shortdesc: Initial interval at which to retry starting networks which failed to start
+	"network.startup.retry_interval": {Type: config.Int64, Default: "60", Validator: validate.Optional(validate.IsInRange(1, 86400))},
+
+	// gendoc:generate(entity=server, group=miscellaneous, key=network.startup.retry_max_interval)
+	// Specify the interval in seconds.
+	// ---
+	//  type: integer
+	//  scope: global
+	//  defaultdesc: `3600`
+	//  shortdesc: Maximum interval at which to retry starting networks which failed to start
+	"network.startup.retry_max_interval": {Type: config.Int64, Default: "3600", Validator: validate.Optional(validate.IsInRange(1, 86400))},
+
 	// gendoc:generate(entity=server, group=miscellaneous, key=storage.linstor.controller_connection)
 	//
 	// ---
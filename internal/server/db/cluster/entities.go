@@ -29,6 +29,8 @@ const (
 	TypeWarning               = 16
 	TypeClusterGroup          = 17
 	TypeStorageBucket         = 18
+	TypeNetworkForward        = 19
+	TypeNetworkLoadBalancer   = 20
 )
 
 // EntityNames associates an entity code to its name.
@@ -41,6 +43,8 @@ var EntityNames = map[int]string{
 	TypeInstance:              "instance",
 	TypeInstanceSnapshot:      "instance snapshot",
 	TypeNetworkACL:            "network acl",
+	TypeNetworkForward:        "network forward",
+	TypeNetworkLoadBalancer:   "network load balancer",
 	TypeNetwork:               "network",
 	TypeNode:                  "node",
 	TypeOperation:             "operation",
@@ -67,6 +71,8 @@ var EntityURIs = map[int]string{
 	TypeInstanceSnapshot:      "/" + version.APIVersion + "/instances/%s/snapshots/%s?project=%s",
 	TypeInstance:              "/" + version.APIVersion + "/instances/%s?project=%s",
 	TypeNetworkACL:            "/" + version.APIVersion + "/network-acls/%s?project=%s",
+	TypeNetworkForward:        "/" + version.APIVersion + "/networks/%s/forwards/%s?project=%s",
+	TypeNetworkLoadBalancer:   "/" + version.APIVersion + "/networks/%s/load-balancers/%s?project=%s",
 	TypeNetwork:               "/" + version.APIVersion + "/networks/%s?project=%s",
 	TypeNode:                  "/" + version.APIVersion + "/cluster/members/%s",
 	TypeOperation:             "/" + version.APIVersion + "/operations/%s",
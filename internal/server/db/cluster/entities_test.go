@@ -88,6 +88,22 @@ func TestURLToEntityType(t *testing.T) {
 			expectedPathArgs:   []string{"my-network-acl"},
 			expectedErr:        nil,
 		},
+		{
+			name:               "network forwards",
+			rawURL:             "/1.0/networks/my-network/forwards/192.0.2.1?project=my-project",
+			expectedEntityType: TypeNetworkForward,
+			expectedProject:    "my-project",
+			expectedPathArgs:   []string{"my-network", "192.0.2.1"},
+			expectedErr:        nil,
+		},
+		{
+			name:               "network load balancers",
+			rawURL:             "/1.0/networks/my-network/load-balancers/192.0.2.2",
+			expectedEntityType: TypeNetworkLoadBalancer,
+			expectedProject:    "default",
+			expectedPathArgs:   []string{"my-network", "192.0.2.2"},
+			expectedErr:        nil,
+		},
 		{
 			name:               "cluster members",
 			rawURL:             "/1.0/cluster/members/node01",
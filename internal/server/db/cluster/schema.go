@@ -217,6 +217,8 @@ CREATE TABLE "networks" (
     description TEXT NOT NULL,
     state INTEGER NOT NULL DEFAULT 0,
     type INTEGER NOT NULL DEFAULT 0,
+    creation_date DATETIME NOT NULL DEFAULT "0001-01-01T00:00:00Z",
+    uuid TEXT NOT NULL DEFAULT "",
     UNIQUE (project_id, name),
     FOREIGN KEY (project_id) REFERENCES "projects" (id) ON DELETE CASCADE
 );
@@ -352,6 +354,16 @@ CREATE TABLE "networks_peers_config" (
     UNIQUE (network_peer_id, key),
     FOREIGN KEY (network_peer_id) REFERENCES "networks_peers" (id) ON DELETE CASCADE
 );
+CREATE TABLE "networks_scheduled_changes" (
+    id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    network_id INTEGER NOT NULL,
+    apply_date DATETIME NOT NULL,
+    method TEXT NOT NULL,
+    description TEXT NOT NULL,
+    config TEXT NOT NULL,
+    UNIQUE (network_id),
+    FOREIGN KEY (network_id) REFERENCES "networks" (id) ON DELETE CASCADE
+);
 CREATE UNIQUE INDEX networks_unique_network_id_node_id_key ON "networks_config" (network_id, IFNULL(node_id, -1), key);
 CREATE TABLE "networks_zones" (
     id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
@@ -674,5 +686,5 @@ CREATE TABLE "warnings" (
 );
 CREATE UNIQUE INDEX warnings_unique_node_id_project_id_entity_type_code_entity_id_type_code ON warnings(IFNULL(node_id, -1), IFNULL(project_id, -1), entity_type_code, entity_id, type_code);
 
-INSERT INTO schema (version, updated_at) VALUES (76, strftime("%s"))
+INSERT INTO schema (version, updated_at) VALUES (79, strftime("%s"))
 `
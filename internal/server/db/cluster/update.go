@@ -8,6 +8,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
+
 	internalInstance "github.com/lxc/incus/v6/internal/instance"
 	"github.com/lxc/incus/v6/internal/server/db/query"
 	"github.com/lxc/incus/v6/internal/server/db/schema"
@@ -113,6 +115,63 @@ var updates = map[int]schema.Update{
 	74: updateFromV73,
 	75: updateFromV74,
 	76: updateFromV75,
+	77: updateFromV76,
+	78: updateFromV77,
+	79: updateFromV78,
+}
+
+// updateFromV78 adds a stable identifier to networks.
+func updateFromV78(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.Exec(`ALTER TABLE networks ADD COLUMN uuid TEXT NOT NULL DEFAULT "";`)
+	if err != nil {
+		return fmt.Errorf("Failed adding uuid column to networks table: %w", err)
+	}
+
+	ids, err := query.SelectIntegers(ctx, tx, "SELECT id FROM networks")
+	if err != nil {
+		return fmt.Errorf("Failed getting networks: %w", err)
+	}
+
+	for _, id := range ids {
+		_, err = tx.Exec("UPDATE networks SET uuid=? WHERE id=?", uuid.New().String(), id)
+		if err != nil {
+			return fmt.Errorf("Failed setting uuid of network %d: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// updateFromV77 adds a table to hold scheduled network config changes.
+func updateFromV77(ctx context.Context, tx *sql.Tx) error {
+	q := `
+CREATE TABLE "networks_scheduled_changes" (
+    id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    network_id INTEGER NOT NULL,
+    apply_date DATETIME NOT NULL,
+    method TEXT NOT NULL,
+    description TEXT NOT NULL,
+    config TEXT NOT NULL,
+    UNIQUE (network_id),
+    FOREIGN KEY (network_id) REFERENCES "networks" (id) ON DELETE CASCADE
+);
+`
+	_, err := tx.Exec(q)
+	if err != nil {
+		return fmt.Errorf("Failed creating networks_scheduled_changes table: %w", err)
+	}
+
+	return nil
+}
+
+// updateFromV76 adds a creation date to networks.
+func updateFromV76(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.Exec(`ALTER TABLE networks ADD COLUMN creation_date DATETIME NOT NULL DEFAULT "0001-01-01T00:00:00Z";`)
+	if err != nil {
+		return fmt.Errorf("Failed adding creation_date column to networks table: %w", err)
+	}
+
+	return nil
 }
 
 func updateFromV75(ctx context.Context, tx *sql.Tx) error {
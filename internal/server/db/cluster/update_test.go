@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -765,3 +766,33 @@ func TestUpdateFromV34(t *testing.T) {
 	assert.Equal(t, id, 2)
 	assert.Equal(t, nodeID, nil)
 }
+
+func TestUpdateFromV78(t *testing.T) {
+	schema := cluster.Schema()
+	db, err := schema.ExerciseUpdate(79, func(db *sql.DB) {
+		_, err := db.Exec("INSERT INTO projects (name, description) VALUES ('p1', '')")
+		require.NoError(t, err)
+
+		for _, name := range []string{"net1", "net2"} {
+			_, err = db.Exec("INSERT INTO networks (project_id, name, description) VALUES ((SELECT id FROM projects WHERE name = 'p1'), ?, '')", name)
+			require.NoError(t, err)
+		}
+	})
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+	defer func() { _ = tx.Rollback() }()
+
+	// Each existing network got its own UUID.
+	uuids, err := query.SelectStrings(context.Background(), tx, "SELECT uuid FROM networks ORDER BY name")
+	require.NoError(t, err)
+	require.Len(t, uuids, 2)
+	assert.NotEqual(t, uuids[0], uuids[1])
+
+	for _, networkUUID := range uuids {
+		_, err = uuid.Parse(networkUUID)
+		assert.NoError(t, err)
+	}
+}
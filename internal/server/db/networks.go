@@ -11,6 +11,9 @@ import (
 	"regexp"
 	"slices"
 	"strings"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/lxc/incus/v6/internal/server/db/cluster"
 	"github.com/lxc/incus/v6/internal/server/db/query"
@@ -108,7 +111,7 @@ func (c *ClusterTx) GetCreatedNetworksByProject(ctx context.Context, projectName
 // Supports an optional projectName filter. If projectName is empty, all networks in created state are returned.
 func (c *ClusterTx) getCreatedNetworks(ctx context.Context, projectName string) (map[string]map[int64]api.Network, error) {
 	var sb strings.Builder
-	sb.WriteString(`SELECT projects.name, networks.id, networks.name, coalesce(networks.description, ''), networks.type, networks.state
+	sb.WriteString(`SELECT projects.name, networks.id, networks.name, coalesce(networks.description, ''), networks.type, networks.state, networks.creation_date, networks.uuid
 	FROM networks
 	JOIN projects on projects.id = networks.project_id
 	WHERE networks.state = ?
@@ -137,7 +140,7 @@ func (c *ClusterTx) getCreatedNetworks(ctx context.Context, projectName string)
 		var networkState NetworkState
 		var network api.Network
 
-		err := rows.Scan(&projectName, &networkID, &network.Name, &network.Description, &networkType, &networkState)
+		err := rows.Scan(&projectName, &networkID, &network.Name, &network.Description, &networkType, &networkState, &network.CreatedAt, &network.UUID)
 		if err != nil {
 			return nil, err
 		}
@@ -226,6 +229,28 @@ func (c *ClusterTx) GetNetworkNameAndProjectWithID(ctx context.Context, networkI
 	return networkName, projectName, nil
 }
 
+// GetNetworkNameAndProjectWithUUID returns the network name and project name for the given UUID.
+func (c *ClusterTx) GetNetworkNameAndProjectWithUUID(ctx context.Context, networkUUID string) (string, string, error) {
+	var networkName string
+	var projectName string
+
+	q := `SELECT networks.name, projects.name FROM networks JOIN projects ON projects.id=networks.project_id WHERE networks.uuid=?`
+
+	inargs := []any{networkUUID}
+	outargs := []any{&networkName, &projectName}
+
+	err := dbQueryRowScan(ctx, c, q, inargs, outargs)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", "", api.StatusErrorf(http.StatusNotFound, "Network not found")
+		}
+
+		return "", "", err
+	}
+
+	return networkName, projectName, nil
+}
+
 // CreateNetworkConfig adds a new entry in the networks_config table.
 func (c *ClusterTx) CreateNetworkConfig(networkID, nodeID int64, config map[string]string) error {
 	return networkConfigAdd(c.tx, networkID, nodeID, config)
@@ -325,8 +350,8 @@ func (c *ClusterTx) CreatePendingNetwork(ctx context.Context, node string, proje
 		}
 
 		// No existing network with the given name was found, let's create one.
-		columns := []string{"project_id", "name", "type", "description"}
-		values := []any{projectID, name, netType, description}
+		columns := []string{"project_id", "name", "type", "description", "creation_date", "uuid"}
+		values := []any{projectID, name, netType, description, time.Now().UTC(), uuid.New().String()}
 		networkID, err = query.UpsertObject(c.tx, "networks", columns, values)
 		if err != nil {
 			return err
@@ -409,6 +434,11 @@ func (c *ClusterTx) NetworkNodeCreated(networkID int64) error {
 	return c.networkNodeState(networkID, networkCreated)
 }
 
+// NetworkNodePending sets the state of the given network for the local member to networkPending.
+func (c *ClusterTx) NetworkNodePending(networkID int64) error {
+	return c.networkNodeState(networkID, networkPending)
+}
+
 // networkNodeState updates the network member state for the local member and specified network ID.
 func (c *ClusterTx) networkNodeState(networkID int64, state NetworkState) error {
 	stmt := "UPDATE networks_nodes SET state=? WHERE network_id = ? and node_id = ?"
@@ -613,7 +643,7 @@ func (c *ClusterTx) getPartialNetworkByProjectAndName(ctx context.Context, tx *C
 
 	var q strings.Builder
 
-	q.WriteString(`SELECT n.id, n.name, IFNULL(n.description, "") as description, n.state, n.type
+	q.WriteString(`SELECT n.id, n.name, IFNULL(n.description, "") as description, n.state, n.type, n.creation_date, n.uuid
 		FROM networks AS n
 		WHERE n.project_id = (SELECT id FROM projects WHERE name = ? LIMIT 1)
 		AND n.name=?
@@ -627,7 +657,7 @@ func (c *ClusterTx) getPartialNetworkByProjectAndName(ctx context.Context, tx *C
 
 	q.WriteString(" LIMIT 1")
 
-	err = c.tx.QueryRowContext(ctx, q.String(), args...).Scan(&networkID, &network.Name, &network.Description, &networkState, &networkType)
+	err = c.tx.QueryRowContext(ctx, q.String(), args...).Scan(&networkID, &network.Name, &network.Description, &networkState, &networkType, &network.CreatedAt, &network.UUID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return -1, -1, -1, nil, api.StatusErrorf(http.StatusNotFound, "Network not found")
@@ -731,8 +761,8 @@ func (c *ClusterTx) getNetworkConfig(ctx context.Context, tx *ClusterTx, network
 // CreateNetwork creates a new network.
 func (c *ClusterTx) CreateNetwork(ctx context.Context, projectName string, name string, description string, netType NetworkType, config map[string]string) (int64, error) {
 	// Insert a new network record with state networkCreated.
-	result, err := c.tx.ExecContext(ctx, "INSERT INTO networks (project_id, name, description, state, type) VALUES ((SELECT id FROM projects WHERE name = ?), ?, ?, ?, ?)",
-		projectName, name, description, networkCreated, netType)
+	result, err := c.tx.ExecContext(ctx, "INSERT INTO networks (project_id, name, description, state, type, creation_date, uuid) VALUES ((SELECT id FROM projects WHERE name = ?), ?, ?, ?, ?, ?, ?)",
+		projectName, name, description, networkCreated, netType, time.Now().UTC(), uuid.New().String())
 	if err != nil {
 		return -1, err
 	}
@@ -783,6 +813,16 @@ func (c *ClusterTx) UpdateNetwork(ctx context.Context, project string, name, des
 	return nil
 }
 
+// UpdateNetworkDescription updates the description of the network with the given name, leaving its config untouched.
+func (c *ClusterTx) UpdateNetworkDescription(ctx context.Context, project string, name string, description string) error {
+	id, _, _, err := c.GetNetworkInAnyState(ctx, project, name)
+	if err != nil {
+		return err
+	}
+
+	return updateNetworkDescription(c.tx, id, description)
+}
+
 // Update the description of the network with the given ID.
 func updateNetworkDescription(tx *sql.Tx, id int64, description string) error {
 	_, err := tx.Exec("UPDATE networks SET description=? WHERE id=?", description, id)
@@ -0,0 +1,156 @@
+//go:build linux && cgo && !agent
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/lxc/incus/v6/shared/api"
+)
+
+// NetworkScheduledChange represents a network config change waiting to be applied.
+type NetworkScheduledChange struct {
+	NetworkID   int64
+	Project     string
+	Network     string
+	ApplyAt     time.Time
+	Method      string
+	Description string
+	Config      map[string]string
+}
+
+// ToAPI converts the scheduled change to its API representation.
+func (c NetworkScheduledChange) ToAPI() *api.NetworkScheduledChange {
+	return &api.NetworkScheduledChange{
+		NetworkPut: api.NetworkPut{
+			Config:      c.Config,
+			Description: c.Description,
+		},
+		ApplyAt: c.ApplyAt,
+		Method:  c.Method,
+	}
+}
+
+// getNetworkScheduledChanges returns the scheduled changes matching the given WHERE filter.
+func (c *ClusterTx) getNetworkScheduledChanges(ctx context.Context, where string, args ...any) ([]NetworkScheduledChange, error) {
+	q := `
+	SELECT networks.id, projects.name, networks.name, networks_scheduled_changes.apply_date, networks_scheduled_changes.method, networks_scheduled_changes.description, networks_scheduled_changes.config
+	FROM networks_scheduled_changes
+	JOIN networks ON networks.id = networks_scheduled_changes.network_id
+	JOIN projects ON projects.id = networks.project_id
+	`
+
+	if where != "" {
+		q += " WHERE " + where
+	}
+
+	q += " ORDER BY networks_scheduled_changes.apply_date"
+
+	rows, err := c.tx.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var changes []NetworkScheduledChange
+	for rows.Next() {
+		var change NetworkScheduledChange
+		var config string
+
+		err := rows.Scan(&change.NetworkID, &change.Project, &change.Network, &change.ApplyAt, &change.Method, &change.Description, &config)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal([]byte(config), &change.Config)
+		if err != nil {
+			return nil, fmt.Errorf("Failed parsing scheduled change config for network %q: %w", change.Network, err)
+		}
+
+		changes = append(changes, change)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// GetNetworkScheduledChange returns the scheduled change of the network with the given ID.
+func (c *ClusterTx) GetNetworkScheduledChange(ctx context.Context, networkID int64) (*NetworkScheduledChange, error) {
+	changes, err := c.getNetworkScheduledChanges(ctx, "networks.id = ?", networkID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(changes) == 0 {
+		return nil, api.StatusErrorf(http.StatusNotFound, "Network scheduled change not found")
+	}
+
+	return &changes[0], nil
+}
+
+// GetNetworkScheduledChanges returns all the scheduled changes, keyed by network ID.
+func (c *ClusterTx) GetNetworkScheduledChanges(ctx context.Context) (map[int64]NetworkScheduledChange, error) {
+	changes, err := c.getNetworkScheduledChanges(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64]NetworkScheduledChange, len(changes))
+	for _, change := range changes {
+		result[change.NetworkID] = change
+	}
+
+	return result, nil
+}
+
+// GetDueNetworkScheduledChanges returns all the scheduled changes due to be applied at the given time.
+func (c *ClusterTx) GetDueNetworkScheduledChanges(ctx context.Context, now time.Time) ([]NetworkScheduledChange, error) {
+	return c.getNetworkScheduledChanges(ctx, "networks_scheduled_changes.apply_date <= ?", now.UTC())
+}
+
+// CreateNetworkScheduledChange stores the scheduled change of a network, replacing any existing one.
+func (c *ClusterTx) CreateNetworkScheduledChange(ctx context.Context, networkID int64, applyAt time.Time, method string, description string, config map[string]string) error {
+	if config == nil {
+		config = map[string]string{}
+	}
+
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.tx.ExecContext(ctx, "INSERT OR REPLACE INTO networks_scheduled_changes (network_id, apply_date, method, description, config) VALUES (?, ?, ?, ?, ?)", networkID, applyAt.UTC(), method, description, string(configJSON))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteNetworkScheduledChange deletes the scheduled change of the network with the given ID.
+func (c *ClusterTx) DeleteNetworkScheduledChange(ctx context.Context, networkID int64) error {
+	result, err := c.tx.ExecContext(ctx, "DELETE FROM networks_scheduled_changes WHERE network_id = ?", networkID)
+	if err != nil {
+		return err
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return api.StatusErrorf(http.StatusNotFound, "Network scheduled change not found")
+	}
+
+	return nil
+}
@@ -5,11 +5,13 @@ package db_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/lxc/incus/v6/internal/server/db"
+	"github.com/lxc/incus/v6/internal/server/db/cluster"
 	"github.com/lxc/incus/v6/internal/server/response"
 	"github.com/lxc/incus/v6/shared/api"
 )
@@ -105,3 +107,102 @@ func TestNetworksCreatePending_NonExistingNode(t *testing.T) {
 	err := tx.CreatePendingNetwork(context.Background(), "buzz", api.ProjectDefaultName, "network1", "", db.NetworkTypeBridge, map[string]string{})
 	require.True(t, response.IsNotFoundError(err))
 }
+
+// The UpdateNetworkDescription method only changes the description of the network.
+func TestUpdateNetworkDescription(t *testing.T) {
+	tx, cleanup := db.NewTestClusterTx(t)
+	defer cleanup()
+
+	config := map[string]string{"dns.mode": "none"}
+	_, err := tx.CreateNetwork(context.Background(), api.ProjectDefaultName, "incusbr0", "old", db.NetworkTypeBridge, config)
+	require.NoError(t, err)
+
+	err = tx.UpdateNetworkDescription(context.Background(), api.ProjectDefaultName, "incusbr0", "new")
+	require.NoError(t, err)
+
+	_, network, _, err := tx.GetNetworkInAnyState(context.Background(), api.ProjectDefaultName, "incusbr0")
+	require.NoError(t, err)
+	assert.Equal(t, "new", network.Description)
+	assert.Equal(t, config, network.Config)
+
+	err = tx.UpdateNetworkDescription(context.Background(), api.ProjectDefaultName, "missing", "new")
+	require.True(t, response.IsNotFoundError(err))
+}
+
+// Networks can be looked up by their UUID regardless of their project.
+func TestGetNetworkNameAndProjectWithUUID(t *testing.T) {
+	tx, cleanup := db.NewTestClusterTx(t)
+	defer cleanup()
+
+	project1 := cluster.Project{}
+	project1.Name = "p1"
+	_, err := cluster.CreateProject(context.Background(), tx.Tx(), project1)
+	require.NoError(t, err)
+
+	uuids := map[string]string{}
+	for _, projectName := range []string{api.ProjectDefaultName, "p1"} {
+		_, err = tx.CreateNetwork(context.Background(), projectName, "incusbr0", "", db.NetworkTypeBridge, map[string]string{})
+		require.NoError(t, err)
+
+		_, network, _, err := tx.GetNetworkInAnyState(context.Background(), projectName, "incusbr0")
+		require.NoError(t, err)
+		require.NotEmpty(t, network.UUID)
+
+		uuids[projectName] = network.UUID
+	}
+
+	assert.NotEqual(t, uuids[api.ProjectDefaultName], uuids["p1"])
+
+	for projectName, networkUUID := range uuids {
+		name, project, err := tx.GetNetworkNameAndProjectWithUUID(context.Background(), networkUUID)
+		require.NoError(t, err)
+		assert.Equal(t, "incusbr0", name)
+		assert.Equal(t, projectName, project)
+	}
+
+	_, _, err = tx.GetNetworkNameAndProjectWithUUID(context.Background(), "2b4b8d4a-5b4e-4b8a-9f9c-1c1c1c1c1c1c")
+	require.True(t, response.IsNotFoundError(err))
+}
+
+// Scheduled changes can be retrieved per network, all at once or when due.
+func TestNetworkScheduledChanges(t *testing.T) {
+	tx, cleanup := db.NewTestClusterTx(t)
+	defer cleanup()
+
+	network1, err := tx.CreateNetwork(context.Background(), api.ProjectDefaultName, "network1", "", db.NetworkTypeBridge, map[string]string{})
+	require.NoError(t, err)
+
+	network2, err := tx.CreateNetwork(context.Background(), api.ProjectDefaultName, "network2", "", db.NetworkTypeBridge, map[string]string{})
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	err = tx.CreateNetworkScheduledChange(context.Background(), network1, now.Add(time.Hour), "PATCH", "later", map[string]string{"ipv4.nat": "true"})
+	require.NoError(t, err)
+
+	err = tx.CreateNetworkScheduledChange(context.Background(), network2, now.Add(-time.Hour), "PUT", "due", nil)
+	require.NoError(t, err)
+
+	change, err := tx.GetNetworkScheduledChange(context.Background(), network1)
+	require.NoError(t, err)
+	assert.Equal(t, "network1", change.Network)
+	assert.Equal(t, "PATCH", change.Method)
+	assert.Equal(t, map[string]string{"ipv4.nat": "true"}, change.Config)
+
+	changes, err := tx.GetNetworkScheduledChanges(context.Background())
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, "later", changes[network1].Description)
+	assert.Equal(t, "due", changes[network2].Description)
+
+	due, err := tx.GetDueNetworkScheduledChanges(context.Background(), now)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, network2, due[0].NetworkID)
+
+	err = tx.DeleteNetworkScheduledChange(context.Background(), network1)
+	require.NoError(t, err)
+
+	_, err = tx.GetNetworkScheduledChange(context.Background(), network1)
+	require.True(t, response.IsNotFoundError(err))
+}
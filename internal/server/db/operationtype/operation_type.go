@@ -79,6 +79,8 @@ const (
 	BucketBackupRemove
 	BucketBackupRename
 	BucketBackupRestore
+	NetworkCreate
+	NetworkRestart
 )
 
 // Description return a human-readable description of the operation type.
@@ -210,6 +212,10 @@ func (t Type) Description() string {
 		return "Renaming bucket backup"
 	case BucketBackupRestore:
 		return "Restoring bucket backup"
+	case NetworkCreate:
+		return "Creating network"
+	case NetworkRestart:
+		return "Restarting network"
 	default:
 		return "Executing operation"
 	}
@@ -301,6 +307,11 @@ func (t Type) Permission() (auth.ObjectType, auth.Entitlement) {
 	case BucketBackupRestore:
 		return auth.ObjectTypeStorageVolume, auth.EntitlementCanEdit
 
+	case NetworkCreate:
+		return auth.ObjectTypeProject, auth.EntitlementCanCreateNetworks
+	case NetworkRestart:
+		return auth.ObjectTypeNetwork, auth.EntitlementCanEdit
+
 	default:
 		return "", ""
 	}
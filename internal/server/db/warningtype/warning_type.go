@@ -56,6 +56,10 @@ const (
 	StoragePoolUnvailable
 	// UnableToUpdateClusterCertificate represents the unable to update cluster certificate warning.
 	UnableToUpdateClusterCertificate
+	// NetworkDrift represents a network whose dataplane drifted from its configuration on the local server.
+	NetworkDrift
+	// NetworkDHCPPoolNearlyExhausted represents a network whose DHCP address pool is nearly exhausted.
+	NetworkDHCPPoolNearlyExhausted
 )
 
 // TypeNames associates a warning code to its name.
@@ -84,6 +88,8 @@ var TypeNames = map[Type]string{
 	InstanceTypeNotOperational:        "Instance type not operational",
 	StoragePoolUnvailable:             "Storage pool unavailable",
 	UnableToUpdateClusterCertificate:  "Unable to update cluster certificate",
+	NetworkDrift:                      "Network dataplane drifted from its configuration",
+	NetworkDHCPPoolNearlyExhausted:    "Network DHCP address pool nearly exhausted",
 }
 
 // Severity returns the severity of the warning type.
@@ -137,6 +143,10 @@ func (t Type) Severity() Severity {
 		return SeverityHigh
 	case UnableToUpdateClusterCertificate:
 		return SeverityLow
+	case NetworkDrift:
+		return SeverityModerate
+	case NetworkDHCPPoolNearlyExhausted:
+		return SeverityModerate
 	}
 
 	return SeverityLow
@@ -125,3 +125,74 @@ func (u32 *U32Filter) Add() error {
 
 	return nil
 }
+
+// GetRateLimits returns the rates in bits per second enforced on the device, first by the HTB classes of its root
+// qdisc (traffic sent by the device) and then by the policing filters of its ingress qdisc (traffic received by
+// the device). A zero rate means that no limit is applied.
+func GetRateLimits(dev string) (uint64, uint64, error) {
+	link, err := linkByName(dev)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	classes, err := netlink.ClassList(link, netlink.HANDLE_NONE)
+	if err != nil {
+		return 0, 0, fmt.Errorf("Failed listing tc classes: %w", err)
+	}
+
+	ingressHandle, err := parseHandle("ffff:0")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	filters, err := netlink.FilterList(link, ingressHandle)
+	if err != nil {
+		return 0, 0, fmt.Errorf("Failed listing tc filters: %w", err)
+	}
+
+	return classesRateLimit(classes), filtersRateLimit(filters), nil
+}
+
+// classesRateLimit returns the lowest rate in bits per second of the HTB classes, or zero if none has a rate.
+func classesRateLimit(classes []netlink.Class) uint64 {
+	var limit uint64
+	for _, class := range classes {
+		htbClass, ok := class.(*netlink.HtbClass)
+		if !ok || htbClass.Rate == 0 {
+			continue
+		}
+
+		rate := htbClass.Rate * 8
+		if limit == 0 || rate < limit {
+			limit = rate
+		}
+	}
+
+	return limit
+}
+
+// filtersRateLimit returns the lowest rate in bits per second of the policing actions of the u32 filters, or
+// zero if none has a rate.
+func filtersRateLimit(filters []netlink.Filter) uint64 {
+	var limit uint64
+	for _, filter := range filters {
+		u32Filter, ok := filter.(*netlink.U32)
+		if !ok {
+			continue
+		}
+
+		for _, action := range u32Filter.Actions {
+			policeAction, ok := action.(*netlink.PoliceAction)
+			if !ok || policeAction.Rate == 0 {
+				continue
+			}
+
+			rate := uint64(policeAction.Rate) * 8
+			if limit == 0 || rate < limit {
+				limit = rate
+			}
+		}
+	}
+
+	return limit
+}
@@ -0,0 +1,79 @@
+package ip
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+)
+
+func TestClassesRateLimit(t *testing.T) {
+	tests := []struct {
+		name     string
+		classes  []netlink.Class
+		expected uint64
+	}{
+		{
+			"No classes",
+			nil,
+			0,
+		},
+		{
+			"Classes without rate",
+			[]netlink.Class{&netlink.HtbClass{}, &netlink.GenericClass{}},
+			0,
+		},
+		{
+			"Lowest rate",
+			[]netlink.Class{&netlink.HtbClass{Rate: 12500000}, &netlink.GenericClass{}, &netlink.HtbClass{Rate: 1250000}, &netlink.HtbClass{}},
+			10000000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, classesRateLimit(tt.classes))
+		})
+	}
+}
+
+func TestFiltersRateLimit(t *testing.T) {
+	police := func(rate uint32) netlink.Action {
+		action := netlink.NewPoliceAction()
+		action.Rate = rate
+
+		return action
+	}
+
+	tests := []struct {
+		name     string
+		filters  []netlink.Filter
+		expected uint64
+	}{
+		{
+			"No filters",
+			nil,
+			0,
+		},
+		{
+			"Filters without policing",
+			[]netlink.Filter{&netlink.U32{Actions: []netlink.Action{&netlink.GenericAction{}}}, &netlink.GenericFilter{}},
+			0,
+		},
+		{
+			"Lowest rate",
+			[]netlink.Filter{
+				&netlink.U32{Actions: []netlink.Action{police(12500000)}},
+				&netlink.U32{Actions: []netlink.Action{police(0), police(1250000)}},
+				&netlink.GenericFilter{},
+			},
+			10000000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, filtersRateLimit(tt.filters))
+		})
+	}
+}
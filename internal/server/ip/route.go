@@ -316,3 +316,35 @@ func (r *Route) List() ([]Route, error) {
 
 	return routes, nil
 }
+
+// DefaultGateways returns the next hops of the default routes of the main routing table for the given family.
+func DefaultGateways(family Family) ([]net.IP, error) {
+	filter := &netlink.Route{Table: unix.RT_TABLE_MAIN}
+
+	netlinkRoutes, err := netlink.RouteListFiltered(int(family), filter, netlink.RT_FILTER_TABLE)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list routes: %w", err)
+	}
+
+	gateways := []net.IP{}
+	for _, netlinkRoute := range netlinkRoutes {
+		if netlinkRoute.Dst != nil {
+			ones, _ := netlinkRoute.Dst.Mask.Size()
+			if ones != 0 {
+				continue
+			}
+		}
+
+		if netlinkRoute.Gw != nil {
+			gateways = append(gateways, netlinkRoute.Gw)
+		}
+
+		for _, nextHop := range netlinkRoute.MultiPath {
+			if nextHop.Gw != nil {
+				gateways = append(gateways, nextHop.Gw)
+			}
+		}
+	}
+
+	return gateways, nil
+}
@@ -18,6 +18,7 @@ type NetworkAction string
 const (
 	NetworkCreated = NetworkAction(api.EventLifecycleNetworkCreated)
 	NetworkDeleted = NetworkAction(api.EventLifecycleNetworkDeleted)
+	NetworkErrored = NetworkAction(api.EventLifecycleNetworkErrored)
 	NetworkUpdated = NetworkAction(api.EventLifecycleNetworkUpdated)
 	NetworkRenamed = NetworkAction(api.EventLifecycleNetworkRenamed)
 )
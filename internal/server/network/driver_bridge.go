@@ -3,6 +3,7 @@ package network
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/fs"
@@ -33,6 +34,7 @@ import (
 	"github.com/lxc/incus/v6/internal/server/network/acl"
 	addressset "github.com/lxc/incus/v6/internal/server/network/address-set"
 	"github.com/lxc/incus/v6/internal/server/project"
+	"github.com/lxc/incus/v6/internal/server/resources"
 	localUtil "github.com/lxc/incus/v6/internal/server/util"
 	"github.com/lxc/incus/v6/internal/server/warnings"
 	internalUtil "github.com/lxc/incus/v6/internal/util"
@@ -66,6 +68,11 @@ func (n *bridge) Info() Info {
 	return info
 }
 
+// RestartKeys returns the config keys whose change requires restarting the network.
+func (n *bridge) RestartKeys() []string {
+	return []string{"bridge.driver"}
+}
+
 // checkClusterWideMACSafe returns whether it is safe to use the same MAC address for the bridge interface on all
 // cluster nodes. It is not suitable to use a static MAC address when "bridge.external_interfaces" is non-empty and
 // the bridge interface has no IPv4 or IPv6 address set. This is because in a clustered environment the same bridge
@@ -304,6 +311,18 @@ func (n *bridge) Validate(config map[string]string) error {
 		//  shortdesc: Comma-separated list of IP ranges to use for DHCP (FIRST-LAST format)
 		"ipv4.dhcp.ranges": validate.Optional(validate.IsListOf(validate.IsNetworkRangeV4)),
 
+		// gendoc:generate(entity=network_bridge, group=common, key=ipv4.dhcp.reservations)
+		//
+		// ---
+		//  type: string
+		//  condition: IPv4 DHCP
+		//  default: -
+		//  shortdesc: Comma-separated list of static DHCP reservations (`MAC ADDRESS [HOSTNAME]` format)
+		"ipv4.dhcp.reservations": validate.Optional(func(value string) error {
+			_, err := parseDHCPReservations(value, 4)
+			return err
+		}),
+
 		// gendoc:generate(entity=network_bridge, group=common, key=ipv4.dhcp.routes)
 		//
 		// ---
@@ -418,6 +437,15 @@ func (n *bridge) Validate(config map[string]string) error {
 		//  shortdesc: Whether to allocate addresses using DHCP
 		"ipv6.dhcp.stateful": validate.Optional(validate.IsBool),
 
+		// gendoc:generate(entity=network_bridge, group=common, key=ipv6.ra)
+		//
+		// ---
+		//  type: bool
+		//  condition: IPv6 address
+		//  default: `true`
+		//  shortdesc: Whether to send router advertisements (disable when an external router advertises the subnet, stateless DHCPv6 is then not offered)
+		"ipv6.ra": validate.Optional(validate.IsBool),
+
 		// gendoc:generate(entity=network_bridge, group=common, key=ipv6.dhcp.ranges)
 		//
 		// ---
@@ -427,6 +455,18 @@ func (n *bridge) Validate(config map[string]string) error {
 		//  shortdesc: Comma-separated list of IPv6 ranges to use for DHCP (FIRST-LAST format)
 		"ipv6.dhcp.ranges": validate.Optional(validate.IsListOf(validate.IsNetworkRangeV6)),
 
+		// gendoc:generate(entity=network_bridge, group=common, key=ipv6.dhcp.reservations)
+		//
+		// ---
+		//  type: string
+		//  condition: IPv6 stateful DHCP
+		//  default: -
+		//  shortdesc: Comma-separated list of static DHCP reservations (`MAC ADDRESS [HOSTNAME]` format)
+		"ipv6.dhcp.reservations": validate.Optional(func(value string) error {
+			_, err := parseDHCPReservations(value, 6)
+			return err
+		}),
+
 		// gendoc:generate(entity=network_bridge, group=common, key=ipv6.routes)
 		//
 		// ---
@@ -824,6 +864,58 @@ func (n *bridge) Validate(config map[string]string) error {
 		}
 	}
 
+	// Check the gateway addresses aren't handed out to DHCP clients.
+	// This is only enforced when either setting changes so that existing networks can still be updated.
+	for _, keyPrefix := range []string{"ipv4", "ipv6"} {
+		if validate.IsOneOf("", "none", "auto")(config[keyPrefix+".address"]) == nil || config[keyPrefix+".dhcp.ranges"] == "" {
+			continue
+		}
+
+		if config[keyPrefix+".address"] == n.config[keyPrefix+".address"] && config[keyPrefix+".dhcp.ranges"] == n.config[keyPrefix+".dhcp.ranges"] {
+			continue
+		}
+
+		gateway, _, err := net.ParseCIDR(config[keyPrefix+".address"])
+		if err != nil {
+			return fmt.Errorf("Failed parsing %s.address: %w", keyPrefix, err)
+		}
+
+		dhcpRanges, err := parseIPRanges(config[keyPrefix+".dhcp.ranges"])
+		if err != nil {
+			return fmt.Errorf("Failed parsing %s.dhcp.ranges: %w", keyPrefix, err)
+		}
+
+		for _, dhcpRange := range dhcpRanges {
+			if dhcpRange.ContainsIP(gateway) {
+				return fmt.Errorf("The gateway address %q in %q cannot be within %q", gateway.String(), keyPrefix+".address", keyPrefix+".dhcp.ranges")
+			}
+		}
+	}
+
+	// Check the DHCP reservations are within the subnets and don't use the gateway addresses.
+	for _, family := range []int{4, 6} {
+		keyPrefix := fmt.Sprintf("ipv%d", family)
+		if config[keyPrefix+".dhcp.reservations"] == "" || validate.IsOneOf("", "none", "auto")(config[keyPrefix+".address"]) == nil {
+			continue
+		}
+
+		gateway, subnet, err := net.ParseCIDR(config[keyPrefix+".address"])
+		if err != nil {
+			return fmt.Errorf("Failed parsing %s.address: %w", keyPrefix, err)
+		}
+
+		reservations, err := parseDHCPReservations(config[keyPrefix+".dhcp.reservations"], family)
+		if err != nil {
+			return err
+		}
+
+		for _, reservation := range reservations {
+			if !subnet.Contains(reservation.address) || reservation.address.Equal(gateway) {
+				return fmt.Errorf("The DHCP reservation address %q in %q isn't usable in the network's subnet", reservation.address.String(), keyPrefix+".dhcp.reservations")
+			}
+		}
+	}
+
 	// Check Security ACLs are supported and exist.
 	if config["security.acls"] != "" {
 		err = acl.Exists(n.state, n.Project(), util.SplitNTrimSpace(config["security.acls"], ",", -1, true)...)
@@ -851,6 +943,218 @@ func (n *bridge) isRunning() bool {
 	return InterfaceExists(n.name)
 }
 
+// CheckDataplane checks that the bridge interface exists on this member and that its MTU and addresses match
+// the network's config.
+func (n *bridge) CheckDataplane() error {
+	if !n.isRunning() {
+		return fmt.Errorf("Bridge interface %q not found", n.name)
+	}
+
+	if n.config["bridge.mtu"] != "" {
+		mtu, err := n.getBridgeMTU()
+		if err != nil {
+			return err
+		}
+
+		currentMTU, err := GetDevMTU(n.name)
+		if err != nil {
+			return err
+		}
+
+		if currentMTU != mtu {
+			return fmt.Errorf("Bridge interface %q has MTU %d instead of %d", n.name, currentMTU, mtu)
+		}
+	}
+
+	iface, err := net.InterfaceByName(n.name)
+	if err != nil {
+		return err
+	}
+
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return err
+	}
+
+	for _, keyPrefix := range []string{"ipv4", "ipv6"} {
+		if validate.IsOneOf("", "none", "auto")(n.config[keyPrefix+".address"]) == nil {
+			continue
+		}
+
+		gateway, _, err := net.ParseCIDR(n.config[keyPrefix+".address"])
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for _, addr := range addrs {
+			ipNet, ok := addr.(*net.IPNet)
+			if ok && ipNet.IP.Equal(gateway) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("Bridge interface %q is missing address %q", n.name, n.config[keyPrefix+".address"])
+		}
+	}
+
+	return nil
+}
+
+// State returns the bridge interface state along with the router advertisement state.
+func (n *bridge) State() (*api.NetworkState, error) {
+	state, err := n.common.State()
+	if err != nil {
+		return nil, err
+	}
+
+	state.IPv6RouterAdvertisements = n.ipv6RouterAdvertisements()
+
+	mtu, err := n.getBridgeMTU()
+	if err != nil {
+		return nil, err
+	}
+
+	state.EffectiveMTU = &api.NetworkStateEffectiveMTU{MTU: mtu, Source: n.getBridgeMTUSource()}
+
+	forwardMode := func(family string) string {
+		if util.IsNoneOrEmpty(n.config[family+".address"]) {
+			return "none"
+		}
+
+		if util.IsTrue(n.config[family+".nat"]) {
+			return "nat"
+		}
+
+		return "routed"
+	}
+
+	state.Firewall = &api.NetworkStateFirewall{
+		Driver:       n.state.Firewall.String(),
+		IPv4Mode:     forwardMode("ipv4"),
+		IPv6Mode:     forwardMode("ipv6"),
+		IPv4Firewall: n.hasIPv4Firewall(),
+		IPv6Firewall: n.hasIPv6Firewall(),
+	}
+
+	if state.Counters != nil {
+		lifetimeCounters, err := n.savedCounters()
+		if err != nil {
+			return nil, err
+		}
+
+		lifetimeCounters.BytesReceived += state.Counters.BytesReceived
+		lifetimeCounters.BytesSent += state.Counters.BytesSent
+		lifetimeCounters.PacketsReceived += state.Counters.PacketsReceived
+		lifetimeCounters.PacketsSent += state.Counters.PacketsSent
+		state.LifetimeCounters = lifetimeCounters
+	}
+
+	return state, nil
+}
+
+// QoS returns the traffic limits applied to the ports of the bridge, such as the instance NIC limits.
+func (n *bridge) QoS() ([]api.NetworkStateQoSPort, error) {
+	qos := []api.NetworkStateQoSPort{}
+	if !n.isRunning() {
+		return qos, nil
+	}
+
+	var ports []string
+	if n.config["bridge.driver"] == "openvswitch" {
+		vswitch, err := n.state.OVS()
+		if err != nil {
+			return nil, fmt.Errorf("Failed to connect to OVS: %w", err)
+		}
+
+		ports, err = vswitch.GetBridgePorts(context.TODO(), n.name)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get port list: %w", err)
+		}
+	} else {
+		entries, err := os.ReadDir(fmt.Sprintf("/sys/class/net/%s/brif", n.name))
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get port list: %w", err)
+		}
+
+		for _, entry := range entries {
+			ports = append(ports, entry.Name())
+		}
+	}
+
+	for _, port := range ports {
+		// The ports sending limit applies to the traffic towards the attached device and the other way around.
+		ingress, egress, err := ip.GetRateLimits(port)
+		if err != nil {
+			// The port may have gone away in the meantime.
+			if !InterfaceExists(port) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		if ingress == 0 && egress == 0 {
+			continue
+		}
+
+		qos = append(qos, api.NetworkStateQoSPort{Name: port, Ingress: ingress, Egress: egress})
+	}
+
+	slices.SortFunc(qos, func(a api.NetworkStateQoSPort, b api.NetworkStateQoSPort) int { return strings.Compare(a.Name, b.Name) })
+
+	return qos, nil
+}
+
+// savedCounters returns the counters accumulated by the previous instances of the bridge interface.
+func (n *bridge) savedCounters() (*api.NetworkStateCounters, error) {
+	counters := &api.NetworkStateCounters{}
+
+	content, err := os.ReadFile(internalUtil.VarPath("networks", n.name, "counters.json"))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return counters, nil
+		}
+
+		return nil, fmt.Errorf("Failed reading saved counters: %w", err)
+	}
+
+	err = json.Unmarshal(content, counters)
+	if err != nil {
+		return nil, fmt.Errorf("Failed parsing saved counters: %w", err)
+	}
+
+	return counters, nil
+}
+
+// checkpointCounters adds the counters of the bridge interface to the saved counters, so that the lifetime
+// totals survive the interface being recreated.
+func (n *bridge) checkpointCounters() error {
+	current, err := resources.GetNetworkCounters(n.name)
+	if err != nil {
+		return err
+	}
+
+	counters, err := n.savedCounters()
+	if err != nil {
+		return err
+	}
+
+	counters.BytesReceived += current.BytesReceived
+	counters.BytesSent += current.BytesSent
+	counters.PacketsReceived += current.PacketsReceived
+	counters.PacketsSent += current.PacketsSent
+
+	content, err := json.Marshal(counters)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(internalUtil.VarPath("networks", n.name, "counters.json"), content, 0o600)
+}
+
 // Delete deletes a network.
 func (n *bridge) Delete(clientType request.ClientType) error {
 	n.logger.Debug("Delete", logger.Ctx{"clientType": clientType})
@@ -943,6 +1247,276 @@ func (n *bridge) Start() error {
 	return nil
 }
 
+// getBridgeMTU returns the MTU to use for the bridge interface.
+func (n *bridge) getBridgeMTU() (uint32, error) {
+	if n.config["bridge.mtu"] != "" {
+		mtu, err := strconv.ParseUint(n.config["bridge.mtu"], 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("Invalid MTU %q: %w", n.config["bridge.mtu"], err)
+		}
+
+		return uint32(mtu), nil
+	}
+
+	// Leave room for the tunnel encapsulation.
+	if len(n.getTunnels()) > 0 {
+		return 1400, nil
+	}
+
+	return bridgeMTUDefault, nil
+}
+
+// getBridgeMTUSource returns where the MTU returned by getBridgeMTU comes from.
+func (n *bridge) getBridgeMTUSource() string {
+	if n.config["bridge.mtu"] != "" {
+		return "config"
+	}
+
+	if len(n.getTunnels()) > 0 {
+		return "tunnels"
+	}
+
+	return "default"
+}
+
+// Render returns the dnsmasq configuration resulting from the network's current config, without applying it.
+func (n *bridge) Render() (map[string]string, error) {
+	rendered := map[string]string{}
+	if !n.UsesDNSMasq() {
+		return rendered, nil
+	}
+
+	mtu, err := n.getBridgeMTU()
+	if err != nil {
+		return nil, err
+	}
+
+	dnsmasqArgs, err := n.dnsmasqArgs(mtu)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered["dnsmasq.args"] = strings.Join(dnsmasqArgs, "\n") + "\n"
+	rendered["dnsmasq.raw"] = n.config["raw.dnsmasq"] + "\n"
+
+	return rendered, nil
+}
+
+// dnsmasqArgs returns the dnsmasq command line arguments for the network's current config.
+func (n *bridge) dnsmasqArgs(mtu uint32) ([]string, error) {
+	dnsmasqCmd := []string{
+		"--keep-in-foreground", "--strict-order", "--bind-interfaces",
+		"--except-interface=lo",
+		"--pid-file=", // Disable attempt at writing a PID file.
+		"--no-ping",   // --no-ping is very important to prevent delays to lease file updates.
+		fmt.Sprintf("--interface=%s", n.name),
+	}
+
+	dnsmasqVersion, err := dnsmasq.GetVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	// --dhcp-rapid-commit option is only supported on >2.79.
+	minVer, _ := version.NewDottedVersion("2.79")
+	if dnsmasqVersion.Compare(minVer) > 0 {
+		dnsmasqCmd = append(dnsmasqCmd, "--dhcp-rapid-commit")
+	}
+
+	// --no-negcache option is only supported on >2.47.
+	minVer, _ = version.NewDottedVersion("2.47")
+	if dnsmasqVersion.Compare(minVer) > 0 {
+		dnsmasqCmd = append(dnsmasqCmd, "--no-negcache")
+	}
+
+	if !daemon.Debug {
+		// --quiet options are only supported on >2.67.
+		minVer, _ := version.NewDottedVersion("2.67")
+
+		if dnsmasqVersion.Compare(minVer) > 0 {
+			dnsmasqCmd = append(dnsmasqCmd, []string{"--quiet-dhcp", "--quiet-dhcp6", "--quiet-ra"}...)
+		}
+	}
+
+	var dnsIPv4 []string
+	var dnsIPv6 []string
+	for _, s := range util.SplitNTrimSpace(n.config["dns.nameservers"], ",", -1, false) {
+		if net.ParseIP(s).To4() != nil {
+			dnsIPv4 = append(dnsIPv4, s)
+		} else {
+			dnsIPv6 = append(dnsIPv6, s)
+		}
+	}
+
+	// Configure IPv4.
+	if !util.IsNoneOrEmpty(n.config["ipv4.address"]) {
+		ipAddress, subnet, err := net.ParseCIDR(n.config["ipv4.address"])
+		if err != nil {
+			return nil, fmt.Errorf("Failed parsing ipv4.address: %w", err)
+		}
+
+		dnsmasqCmd = append(dnsmasqCmd, fmt.Sprintf("--listen-address=%s", ipAddress.String()))
+		if n.DHCPv4Subnet() != nil {
+			if !slices.Contains(dnsmasqCmd, "--dhcp-no-override") {
+				dnsmasqCmd = append(dnsmasqCmd, []string{"--dhcp-no-override", "--dhcp-authoritative", fmt.Sprintf("--dhcp-leasefile=%s", internalUtil.VarPath("networks", n.name, "dnsmasq.leases")), fmt.Sprintf("--dhcp-hostsfile=%s", internalUtil.VarPath("networks", n.name, "dnsmasq.hosts"))}...)
+			}
+
+			if n.config["ipv4.dhcp.gateway"] != "" {
+				dnsmasqCmd = append(dnsmasqCmd, fmt.Sprintf("--dhcp-option-force=3,%s", n.config["ipv4.dhcp.gateway"]))
+			}
+
+			if n.config["dns.nameservers"] != "" {
+				if len(dnsIPv4) == 0 {
+					dnsmasqCmd = append(dnsmasqCmd, "--dhcp-option-force=6")
+				} else {
+					dnsmasqCmd = append(dnsmasqCmd, fmt.Sprintf("--dhcp-option-force=6,%s", strings.Join(dnsIPv4, ",")))
+				}
+			}
+
+			if mtu != bridgeMTUDefault {
+				dnsmasqCmd = append(dnsmasqCmd, fmt.Sprintf("--dhcp-option-force=26,%d", mtu))
+			}
+
+			dnsSearch := n.config["dns.search"]
+			if dnsSearch != "" {
+				dnsmasqCmd = append(dnsmasqCmd, fmt.Sprintf("--dhcp-option-force=119,%s", strings.Trim(dnsSearch, " ")))
+			}
+
+			if n.config["ipv4.dhcp.routes"] != "" {
+				dnsmasqCmd = append(dnsmasqCmd, fmt.Sprintf("--dhcp-option-force=121,%s", strings.ReplaceAll(n.config["ipv4.dhcp.routes"], " ", "")))
+			}
+
+			expiry := "1h"
+			if n.config["ipv4.dhcp.expiry"] != "" {
+				expiry = n.config["ipv4.dhcp.expiry"]
+			}
+
+			if n.config["ipv4.dhcp.ranges"] != "" {
+				for _, dhcpRange := range strings.Split(n.config["ipv4.dhcp.ranges"], ",") {
+					dhcpRange = strings.TrimSpace(dhcpRange)
+					dnsmasqCmd = append(dnsmasqCmd, []string{"--dhcp-range", fmt.Sprintf("%s,%s", strings.ReplaceAll(dhcpRange, "-", ","), expiry)}...)
+				}
+			} else {
+				dnsmasqCmd = append(dnsmasqCmd, []string{"--dhcp-range", fmt.Sprintf("%s,%s,%s", dhcpalloc.GetIP(subnet, 2).String(), dhcpalloc.GetIP(subnet, -2).String(), expiry)}...)
+			}
+		}
+	}
+
+	// Configure IPv6.
+	if !util.IsNoneOrEmpty(n.config["ipv6.address"]) {
+		ipAddress, subnet, err := net.ParseCIDR(n.config["ipv6.address"])
+		if err != nil {
+			return nil, fmt.Errorf("Failed parsing ipv6.address: %w", err)
+		}
+
+		subnetSize, _ := subnet.Mask.Size()
+
+		sendRA := util.IsTrueOrEmpty(n.config["ipv6.ra"])
+
+		dnsmasqCmd = append(dnsmasqCmd, fmt.Sprintf("--listen-address=%s", ipAddress.String()))
+		if sendRA {
+			dnsmasqCmd = append(dnsmasqCmd, "--enable-ra")
+		}
+
+		if n.DHCPv6Subnet() != nil {
+			// Build DHCP configuration.
+			if !slices.Contains(dnsmasqCmd, "--dhcp-no-override") {
+				dnsmasqCmd = append(dnsmasqCmd, []string{"--dhcp-no-override", "--dhcp-authoritative", fmt.Sprintf("--dhcp-leasefile=%s", internalUtil.VarPath("networks", n.name, "dnsmasq.leases")), fmt.Sprintf("--dhcp-hostsfile=%s", internalUtil.VarPath("networks", n.name, "dnsmasq.hosts"))}...)
+			}
+
+			expiry := "1h"
+			if n.config["ipv6.dhcp.expiry"] != "" {
+				expiry = n.config["ipv6.dhcp.expiry"]
+			}
+
+			if util.IsTrue(n.config["ipv6.dhcp.stateful"]) {
+				if n.config["ipv6.dhcp.ranges"] != "" {
+					for _, dhcpRange := range strings.Split(n.config["ipv6.dhcp.ranges"], ",") {
+						dhcpRange = strings.TrimSpace(dhcpRange)
+						dnsmasqCmd = append(dnsmasqCmd, []string{"--dhcp-range", fmt.Sprintf("%s,%d,%s", strings.ReplaceAll(dhcpRange, "-", ","), subnetSize, expiry)}...)
+					}
+				} else {
+					dnsmasqCmd = append(dnsmasqCmd, []string{"--dhcp-range", fmt.Sprintf("%s,%s,%d,%s", dhcpalloc.GetIP(subnet, 2), dhcpalloc.GetIP(subnet, -1), subnetSize, expiry)}...)
+				}
+			} else if sendRA {
+				dnsmasqCmd = append(dnsmasqCmd, []string{"--dhcp-range", fmt.Sprintf("::,constructor:%s,ra-stateless,ra-names", n.name)}...)
+			}
+		} else if sendRA {
+			dnsmasqCmd = append(dnsmasqCmd, []string{"--dhcp-range", fmt.Sprintf("::,constructor:%s,ra-only", n.name)}...)
+		}
+
+		if n.config["dns.nameservers"] != "" {
+			if len(dnsIPv6) == 0 {
+				dnsmasqCmd = append(dnsmasqCmd, "--dhcp-option-force=option6:dns-server")
+			} else {
+				dnsmasqCmd = append(dnsmasqCmd, fmt.Sprintf("--dhcp-option-force=option6:dns-server,[%s]", strings.Join(dnsIPv6, ",")))
+			}
+		}
+	}
+
+	// Add the static DHCP reservations, combining the IPv4 and IPv6 addresses of a MAC into a single entry.
+	reservationHosts := map[string][]string{}
+	reservationNames := map[string]string{}
+	for _, family := range []int{4, 6} {
+		if (family == 4 && n.DHCPv4Subnet() == nil) || (family == 6 && n.DHCPv6Subnet() == nil) {
+			continue
+		}
+
+		reservations, err := parseDHCPReservations(n.config[fmt.Sprintf("ipv%d.dhcp.reservations", family)], family)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, reservation := range reservations {
+			hwaddr := reservation.hwaddr.String()
+			if family == 4 {
+				reservationHosts[hwaddr] = append(reservationHosts[hwaddr], reservation.address.String())
+			} else {
+				reservationHosts[hwaddr] = append(reservationHosts[hwaddr], fmt.Sprintf("[%s]", reservation.address.String()))
+			}
+
+			if reservation.hostname != "" {
+				reservationNames[hwaddr] = reservation.hostname
+			}
+		}
+	}
+
+	for _, hwaddr := range slices.Sorted(maps.Keys(reservationHosts)) {
+		entry := append([]string{hwaddr}, reservationHosts[hwaddr]...)
+		if reservationNames[hwaddr] != "" {
+			entry = append(entry, reservationNames[hwaddr])
+		}
+
+		dnsmasqCmd = append(dnsmasqCmd, fmt.Sprintf("--dhcp-host=%s", strings.Join(entry, ",")))
+	}
+
+	// Setup the dnsmasq domain.
+	dnsDomain := n.config["dns.domain"]
+	if dnsDomain == "" {
+		dnsDomain = "incus"
+	}
+
+	if n.config["dns.mode"] != "none" {
+		dnsmasqCmd = append(dnsmasqCmd, "-s", dnsDomain)
+		dnsmasqCmd = append(dnsmasqCmd, "--interface-name", fmt.Sprintf("_gateway.%s,%s", dnsDomain, n.name))
+		dnsmasqCmd = append(dnsmasqCmd, "-S", fmt.Sprintf("/%s/", dnsDomain))
+	}
+
+	// Additional config is read from a file (which also prevents dnsmasq from reading /etc/dnsmasq.conf).
+	dnsmasqCmd = append(dnsmasqCmd, fmt.Sprintf("--conf-file=%s", internalUtil.VarPath("networks", n.name, "dnsmasq.raw")))
+
+	// Attempt to drop privileges.
+	if n.state.OS.UnprivUser != "" {
+		dnsmasqCmd = append(dnsmasqCmd, []string{"-u", n.state.OS.UnprivUser}...)
+	}
+
+	if n.state.OS.UnprivGroup != "" {
+		dnsmasqCmd = append(dnsmasqCmd, []string{"-g", n.state.OS.UnprivGroup}...)
+	}
+
+	return dnsmasqCmd, nil
+}
+
 // setup restarts the network.
 func (n *bridge) setup(oldConfig map[string]string) error {
 	// If we are in mock mode, just no-op.
@@ -963,31 +1537,23 @@ func (n *bridge) setup(oldConfig map[string]string) error {
 		}
 	}
 
-	var err error
+	// Decide the MTU for the bridge interface.
+	mtu, err := n.getBridgeMTU()
+	if err != nil {
+		return err
+	}
 
 	// Build up the bridge interface's settings.
 	bridge := ip.Bridge{
 		Link: ip.Link{
 			Name: n.name,
-			MTU:  bridgeMTUDefault,
+			MTU:  mtu,
 		},
 	}
 
 	// Get a list of tunnels.
 	tunnels := n.getTunnels()
 
-	// Decide the MTU for the bridge interface.
-	if n.config["bridge.mtu"] != "" {
-		mtuInt, err := strconv.ParseUint(n.config["bridge.mtu"], 10, 32)
-		if err != nil {
-			return fmt.Errorf("Invalid MTU %q: %w", n.config["bridge.mtu"], err)
-		}
-
-		bridge.MTU = uint32(mtuInt)
-	} else if len(tunnels) > 0 {
-		bridge.MTU = 1400
-	}
-
 	// Decide the MAC address of bridge interface.
 	if n.config["bridge.hwaddr"] != "" {
 		bridge.Address, err = net.ParseMAC(n.config["bridge.hwaddr"])
@@ -1310,108 +1876,15 @@ func (n *bridge) setup(oldConfig map[string]string) error {
 				fwOpts.FeaturesV4.ForwardingAllow = true
 			}
 		}
-	}
-
-	// Start building process using subprocess package.
-	command := "dnsmasq"
-	dnsmasqCmd := []string{
-		"--keep-in-foreground", "--strict-order", "--bind-interfaces",
-		"--except-interface=lo",
-		"--pid-file=", // Disable attempt at writing a PID file.
-		"--no-ping",   // --no-ping is very important to prevent delays to lease file updates.
-		fmt.Sprintf("--interface=%s", n.name),
-	}
-
-	dnsmasqVersion, err := dnsmasq.GetVersion()
-	if err != nil {
-		return err
-	}
-
-	// --dhcp-rapid-commit option is only supported on >2.79.
-	minVer, _ := version.NewDottedVersion("2.79")
-	if dnsmasqVersion.Compare(minVer) > 0 {
-		dnsmasqCmd = append(dnsmasqCmd, "--dhcp-rapid-commit")
-	}
-
-	// --no-negcache option is only supported on >2.47.
-	minVer, _ = version.NewDottedVersion("2.47")
-	if dnsmasqVersion.Compare(minVer) > 0 {
-		dnsmasqCmd = append(dnsmasqCmd, "--no-negcache")
-	}
-
-	if !daemon.Debug {
-		// --quiet options are only supported on >2.67.
-		minVer, _ := version.NewDottedVersion("2.67")
-
-		if dnsmasqVersion.Compare(minVer) > 0 {
-			dnsmasqCmd = append(dnsmasqCmd, []string{"--quiet-dhcp", "--quiet-dhcp6", "--quiet-ra"}...)
-		}
-	}
-
-	var dnsIPv4 []string
-	var dnsIPv6 []string
-	for _, s := range util.SplitNTrimSpace(n.config["dns.nameservers"], ",", -1, false) {
-		if net.ParseIP(s).To4() != nil {
-			dnsIPv4 = append(dnsIPv4, s)
-		} else {
-			dnsIPv6 = append(dnsIPv6, s)
-		}
-	}
-
-	// Configure IPv4.
-	if !util.IsNoneOrEmpty(n.config["ipv4.address"]) {
-		// Parse the subnet.
-		ipAddress, subnet, err := net.ParseCIDR(n.config["ipv4.address"])
-		if err != nil {
-			return fmt.Errorf("Failed parsing ipv4.address: %w", err)
-		}
-
-		// Update the dnsmasq config.
-		dnsmasqCmd = append(dnsmasqCmd, fmt.Sprintf("--listen-address=%s", ipAddress.String()))
-		if n.DHCPv4Subnet() != nil {
-			if !slices.Contains(dnsmasqCmd, "--dhcp-no-override") {
-				dnsmasqCmd = append(dnsmasqCmd, []string{"--dhcp-no-override", "--dhcp-authoritative", fmt.Sprintf("--dhcp-leasefile=%s", internalUtil.VarPath("networks", n.name, "dnsmasq.leases")), fmt.Sprintf("--dhcp-hostsfile=%s", internalUtil.VarPath("networks", n.name, "dnsmasq.hosts"))}...)
-			}
-
-			if n.config["ipv4.dhcp.gateway"] != "" {
-				dnsmasqCmd = append(dnsmasqCmd, fmt.Sprintf("--dhcp-option-force=3,%s", n.config["ipv4.dhcp.gateway"]))
-			}
-
-			if n.config["dns.nameservers"] != "" {
-				if len(dnsIPv4) == 0 {
-					dnsmasqCmd = append(dnsmasqCmd, "--dhcp-option-force=6")
-				} else {
-					dnsmasqCmd = append(dnsmasqCmd, fmt.Sprintf("--dhcp-option-force=6,%s", strings.Join(dnsIPv4, ",")))
-				}
-			}
-
-			if bridge.MTU != bridgeMTUDefault {
-				dnsmasqCmd = append(dnsmasqCmd, fmt.Sprintf("--dhcp-option-force=26,%d", bridge.MTU))
-			}
-
-			dnsSearch := n.config["dns.search"]
-			if dnsSearch != "" {
-				dnsmasqCmd = append(dnsmasqCmd, fmt.Sprintf("--dhcp-option-force=119,%s", strings.Trim(dnsSearch, " ")))
-			}
-
-			if n.config["ipv4.dhcp.routes"] != "" {
-				dnsmasqCmd = append(dnsmasqCmd, fmt.Sprintf("--dhcp-option-force=121,%s", strings.ReplaceAll(n.config["ipv4.dhcp.routes"], " ", "")))
-			}
-
-			expiry := "1h"
-			if n.config["ipv4.dhcp.expiry"] != "" {
-				expiry = n.config["ipv4.dhcp.expiry"]
-			}
-
-			if n.config["ipv4.dhcp.ranges"] != "" {
-				for _, dhcpRange := range strings.Split(n.config["ipv4.dhcp.ranges"], ",") {
-					dhcpRange = strings.TrimSpace(dhcpRange)
-					dnsmasqCmd = append(dnsmasqCmd, []string{"--dhcp-range", fmt.Sprintf("%s,%s", strings.ReplaceAll(dhcpRange, "-", ","), expiry)}...)
-				}
-			} else {
-				dnsmasqCmd = append(dnsmasqCmd, []string{"--dhcp-range", fmt.Sprintf("%s,%s,%s", dhcpalloc.GetIP(subnet, 2).String(), dhcpalloc.GetIP(subnet, -2).String(), expiry)}...)
-			}
-		}
+	}
+
+	// Configure IPv4.
+	if !util.IsNoneOrEmpty(n.config["ipv4.address"]) {
+		// Parse the subnet.
+		ipAddress, subnet, err := net.ParseCIDR(n.config["ipv4.address"])
+		if err != nil {
+			return fmt.Errorf("Failed parsing ipv4.address: %w", err)
+		}
 
 		// Add the address.
 		addr := &ip.Addr{
@@ -1534,45 +2007,8 @@ func (n *bridge) setup(oldConfig map[string]string) error {
 			}
 		}
 
-		// Update the dnsmasq config.
-		dnsmasqCmd = append(dnsmasqCmd, []string{fmt.Sprintf("--listen-address=%s", ipAddress.String()), "--enable-ra"}...)
-		if n.DHCPv6Subnet() != nil {
-			if n.hasIPv6Firewall() {
-				fwOpts.FeaturesV6.ICMPDHCPDNSAccess = true
-			}
-
-			// Build DHCP configuration.
-			if !slices.Contains(dnsmasqCmd, "--dhcp-no-override") {
-				dnsmasqCmd = append(dnsmasqCmd, []string{"--dhcp-no-override", "--dhcp-authoritative", fmt.Sprintf("--dhcp-leasefile=%s", internalUtil.VarPath("networks", n.name, "dnsmasq.leases")), fmt.Sprintf("--dhcp-hostsfile=%s", internalUtil.VarPath("networks", n.name, "dnsmasq.hosts"))}...)
-			}
-
-			expiry := "1h"
-			if n.config["ipv6.dhcp.expiry"] != "" {
-				expiry = n.config["ipv6.dhcp.expiry"]
-			}
-
-			if util.IsTrue(n.config["ipv6.dhcp.stateful"]) {
-				if n.config["ipv6.dhcp.ranges"] != "" {
-					for _, dhcpRange := range strings.Split(n.config["ipv6.dhcp.ranges"], ",") {
-						dhcpRange = strings.TrimSpace(dhcpRange)
-						dnsmasqCmd = append(dnsmasqCmd, []string{"--dhcp-range", fmt.Sprintf("%s,%d,%s", strings.ReplaceAll(dhcpRange, "-", ","), subnetSize, expiry)}...)
-					}
-				} else {
-					dnsmasqCmd = append(dnsmasqCmd, []string{"--dhcp-range", fmt.Sprintf("%s,%s,%d,%s", dhcpalloc.GetIP(subnet, 2), dhcpalloc.GetIP(subnet, -1), subnetSize, expiry)}...)
-				}
-			} else {
-				dnsmasqCmd = append(dnsmasqCmd, []string{"--dhcp-range", fmt.Sprintf("::,constructor:%s,ra-stateless,ra-names", n.name)}...)
-			}
-		} else {
-			dnsmasqCmd = append(dnsmasqCmd, []string{"--dhcp-range", fmt.Sprintf("::,constructor:%s,ra-only", n.name)}...)
-		}
-
-		if n.config["dns.nameservers"] != "" {
-			if len(dnsIPv6) == 0 {
-				dnsmasqCmd = append(dnsmasqCmd, "--dhcp-option-force=option6:dns-server")
-			} else {
-				dnsmasqCmd = append(dnsmasqCmd, fmt.Sprintf("--dhcp-option-force=option6:dns-server,[%s]", strings.Join(dnsIPv6, ",")))
-			}
+		if n.DHCPv6Subnet() != nil && n.hasIPv6Firewall() {
+			fwOpts.FeaturesV6.ICMPDHCPDNSAccess = true
 		}
 
 		// Allow forwarding.
@@ -1811,16 +2247,16 @@ func (n *bridge) setup(oldConfig map[string]string) error {
 
 	// Configure dnsmasq.
 	if n.UsesDNSMasq() {
-		// Setup the dnsmasq domain.
-		dnsDomain := n.config["dns.domain"]
-		if dnsDomain == "" {
-			dnsDomain = "incus"
+		// Check for dnsmasq.
+		_, err := exec.LookPath("dnsmasq")
+		if err != nil {
+			return errors.New("dnsmasq is required for managed bridges")
 		}
 
-		if n.config["dns.mode"] != "none" {
-			dnsmasqCmd = append(dnsmasqCmd, "-s", dnsDomain)
-			dnsmasqCmd = append(dnsmasqCmd, "--interface-name", fmt.Sprintf("_gateway.%s,%s", dnsDomain, n.name))
-			dnsmasqCmd = append(dnsmasqCmd, "-S", fmt.Sprintf("/%s/", dnsDomain))
+		command := "dnsmasq"
+		dnsmasqCmd, err := n.dnsmasqArgs(bridge.MTU)
+		if err != nil {
+			return err
 		}
 
 		// Create a config file to contain additional config (and to prevent dnsmasq from reading /etc/dnsmasq.conf)
@@ -1829,17 +2265,6 @@ func (n *bridge) setup(oldConfig map[string]string) error {
 			return err
 		}
 
-		dnsmasqCmd = append(dnsmasqCmd, fmt.Sprintf("--conf-file=%s", internalUtil.VarPath("networks", n.name, "dnsmasq.raw")))
-
-		// Attempt to drop privileges.
-		if n.state.OS.UnprivUser != "" {
-			dnsmasqCmd = append(dnsmasqCmd, []string{"-u", n.state.OS.UnprivUser}...)
-		}
-
-		if n.state.OS.UnprivGroup != "" {
-			dnsmasqCmd = append(dnsmasqCmd, []string{"-g", n.state.OS.UnprivGroup}...)
-		}
-
 		// Create DHCP hosts directory.
 		if !util.PathExists(internalUtil.VarPath("networks", n.name, "dnsmasq.hosts")) {
 			err = os.MkdirAll(internalUtil.VarPath("networks", n.name, "dnsmasq.hosts"), 0o755)
@@ -1848,12 +2273,6 @@ func (n *bridge) setup(oldConfig map[string]string) error {
 			}
 		}
 
-		// Check for dnsmasq.
-		_, err := exec.LookPath("dnsmasq")
-		if err != nil {
-			return errors.New("dnsmasq is required for managed bridges")
-		}
-
 		// Update the static leases.
 		err = UpdateDNSMasqStatic(n.state, n.name)
 		if err != nil {
@@ -1998,8 +2417,14 @@ func (n *bridge) Stop() error {
 		return nil
 	}
 
+	// Save the interface counters before they get lost with the interface.
+	err := n.checkpointCounters()
+	if err != nil {
+		n.logger.Warn("Failed saving network counters", logger.Ctx{"err": err})
+	}
+
 	// Clear BGP.
-	err := n.bgpClear(n.config)
+	err = n.bgpClear(n.config)
 	if err != nil {
 		return err
 	}
@@ -3187,6 +3612,268 @@ func (n *bridge) forwardSetupFirewall() error {
 	return nil
 }
 
+// ImportLeases adds the leases from an existing dnsmasq leases file to the network's leases so that the
+// devices keep their addresses. Leases outside of the network's subnets or conflicting with an existing lease
+// are skipped. Returns the number of imported leases.
+func (n *bridge) ImportLeases(content string) (int, error) {
+	if n.DHCPv4Subnet() == nil && n.DHCPv6Subnet() == nil {
+		return 0, errors.New("The network doesn't have DHCP enabled")
+	}
+
+	imported, err := n.importLeases(content)
+
+	// Start dnsmasq again whether the import succeeded or not, it reads the leases file on startup.
+	setupErr := n.setup(nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if setupErr != nil {
+		return 0, setupErr
+	}
+
+	return imported, nil
+}
+
+// importLeases stops dnsmasq and merges the supplied leases into its leases file.
+// The caller is responsible for starting dnsmasq again.
+func (n *bridge) importLeases(content string) (int, error) {
+	leasesPath := internalUtil.VarPath("networks", n.name, "dnsmasq.leases")
+
+	dnsmasq.ConfigMutex.Lock()
+	defer dnsmasq.ConfigMutex.Unlock()
+
+	// Stop dnsmasq so that it doesn't overwrite the leases file.
+	err := dnsmasq.Kill(n.name, false)
+	if err != nil {
+		return 0, err
+	}
+
+	existing, err := os.ReadFile(leasesPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return 0, err
+	}
+
+	leases, newLines := bridgeMergeLeases(string(existing), content, n.DHCPv4Subnet(), n.DHCPv6Subnet())
+	if len(newLines) > 0 {
+		err = os.WriteFile(leasesPath, []byte(leases), 0o644)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// Keep track of the imported leases so they're reported alongside the instance leases.
+	err = n.updateImportedLeases(leases, newLines)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(newLines), nil
+}
+
+// updateImportedLeases rewrites the list of imported leases, adding the newly imported lease lines and
+// dropping the entries which no longer match a lease of the leases file.
+func (n *bridge) updateImportedLeases(leases string, newLines []string) error {
+	importedPath := internalUtil.VarPath("networks", n.name, "dnsmasq.leases.imported")
+
+	imported, err := os.ReadFile(importedPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	entries := bridgeImportedLeases(string(imported), leases)
+	for _, line := range newLines {
+		fields := strings.Fields(line)
+		entries = append(entries, fields[1]+" "+fields[2])
+	}
+
+	if len(entries) == 0 {
+		err = os.Remove(importedPath)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		return nil
+	}
+
+	return os.WriteFile(importedPath, []byte(strings.Join(entries, "\n")+"\n"), 0o644)
+}
+
+// bridgeMergeLeases appends the supplied dnsmasq leases which are within the subnets and don't conflict with an
+// existing lease to the existing leases. Returns the resulting leases file content and the appended lines.
+func bridgeMergeLeases(existing string, content string, subnetV4 *net.IPNet, subnetV6 *net.IPNet) (string, []string) {
+	usedIPs := map[string]bool{}
+	for _, line := range strings.Split(existing, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 5 {
+			usedIPs[net.ParseIP(fields[2]).String()] = true
+		}
+	}
+
+	var newLines []string
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 5 {
+			continue
+		}
+
+		ip := net.ParseIP(fields[2])
+		if ip == nil || usedIPs[ip.String()] {
+			continue
+		}
+
+		subnet := subnetV4
+		if ip.To4() == nil {
+			subnet = subnetV6
+		} else {
+			_, err := net.ParseMAC(fields[1])
+			if err != nil {
+				continue
+			}
+		}
+
+		if subnet == nil || !subnet.Contains(ip) {
+			continue
+		}
+
+		usedIPs[ip.String()] = true
+		newLines = append(newLines, strings.Join(fields, " "))
+	}
+
+	leases := strings.TrimRight(existing, "\n")
+	if len(newLines) > 0 {
+		if leases != "" {
+			leases += "\n"
+		}
+
+		leases += strings.Join(newLines, "\n")
+	}
+
+	if leases != "" {
+		leases += "\n"
+	}
+
+	return leases, newLines
+}
+
+// bridgeImportedLeases returns the entries of the imported leases list (client identifier and address) which
+// still match a lease of the dnsmasq leases. Entries whose lease expired or was since handed out to another
+// device are dropped.
+func bridgeImportedLeases(imported string, leases string) []string {
+	current := map[string]bool{}
+	for _, line := range strings.Split(leases, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 5 {
+			current[fields[1]+" "+fields[2]] = true
+		}
+	}
+
+	entries := []string{}
+	for _, line := range strings.Split(imported, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+
+		entry := fields[0] + " " + fields[1]
+		if current[entry] && !slices.Contains(entries, entry) {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+// DeleteLease removes the dynamic lease of the given address from the dnsmasq leases file so that the address
+// can be handed out again.
+func (n *bridge) DeleteLease(address string) error {
+	if !n.UsesDNSMasq() || (n.DHCPv4Subnet() == nil && n.DHCPv6Subnet() == nil) {
+		return ErrNotImplemented
+	}
+
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return api.StatusErrorf(http.StatusBadRequest, "Invalid IP address %q", address)
+	}
+
+	stopped, err := n.deleteLease(ip)
+
+	// Start dnsmasq again whether the removal succeeded or not, it reads the leases file on startup.
+	if stopped {
+		setupErr := n.setup(nil)
+		if err == nil {
+			err = setupErr
+		}
+	}
+
+	return err
+}
+
+// deleteLease stops dnsmasq and removes the lease of the given address from its leases file.
+// Returns whether dnsmasq was stopped, in which case the caller is responsible for starting it again.
+func (n *bridge) deleteLease(ip net.IP) (bool, error) {
+	leasesPath := internalUtil.VarPath("networks", n.name, "dnsmasq.leases")
+
+	dnsmasq.ConfigMutex.Lock()
+	defer dnsmasq.ConfigMutex.Unlock()
+
+	content, err := os.ReadFile(leasesPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return false, err
+	}
+
+	_, found := bridgeDeleteLease(string(content), ip)
+	if !found {
+		return false, api.StatusErrorf(http.StatusNotFound, "No lease found for %q", ip.String())
+	}
+
+	// Stop dnsmasq so that it doesn't overwrite the leases file.
+	err = dnsmasq.Kill(n.name, false)
+	if err != nil {
+		return true, err
+	}
+
+	// Re-read the file as dnsmasq may have updated it while stopping.
+	content, err = os.ReadFile(leasesPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return true, err
+	}
+
+	leases, _ := bridgeDeleteLease(string(content), ip)
+
+	err = os.WriteFile(leasesPath, []byte(leases), 0o644)
+	if err != nil {
+		return true, err
+	}
+
+	// Forget about the lease if it was imported.
+	return true, n.updateImportedLeases(leases, nil)
+}
+
+// bridgeDeleteLease removes the lease of the address from the dnsmasq leases.
+// Returns the resulting leases file content and whether a lease was found.
+func bridgeDeleteLease(leases string, ip net.IP) (string, bool) {
+	// isLease returns whether the leases file line is the lease of the address.
+	isLease := func(line string) bool {
+		fields := strings.Fields(line)
+		return len(fields) >= 5 && net.ParseIP(fields[2]).Equal(ip)
+	}
+
+	lines := strings.Split(strings.TrimRight(leases, "\n"), "\n")
+	if !slices.ContainsFunc(lines, isLease) {
+		return leases, false
+	}
+
+	lines = slices.DeleteFunc(lines, isLease)
+
+	result := strings.Join(lines, "\n")
+	if result != "" {
+		result += "\n"
+	}
+
+	return result, true
+}
+
 // Leases returns a list of leases for the bridged network. It will reach out to other cluster members as needed.
 // The projectName passed here refers to the initial project from the API request which may differ from the network's project.
 func (n *bridge) Leases(projectName string, clientType request.ClientType) ([]api.NetworkLease, error) {
@@ -3210,6 +3897,23 @@ func (n *bridge) Leases(projectName string, clientType request.ClientType) ([]ap
 				}
 			}
 
+			// Add the static DHCP reservations.
+			for _, family := range []int{4, 6} {
+				reservations, err := parseDHCPReservations(n.config[fmt.Sprintf("ipv%d.dhcp.reservations", family)], family)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, reservation := range reservations {
+					leases = append(leases, api.NetworkLease{
+						Hostname: reservation.hostname,
+						Address:  reservation.address.String(),
+						Hwaddr:   reservation.hwaddr.String(),
+						Type:     "reservation",
+					})
+				}
+			}
+
 			// Include downstream OVN routers using the network as an uplink.
 			var projectNetworks map[string]map[int64]api.Network
 			err = n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
@@ -3301,6 +4005,53 @@ func (n *bridge) Leases(projectName string, clientType request.ClientType) ([]ap
 		}
 	}
 
+	// Get the SLAAC addresses seen in the local neighbour table.
+	// Those aren't known to dnsmasq so they'd otherwise never show up.
+	_, netIP6, _ := net.ParseCIDR(n.config["ipv6.address"])
+	if netIP6 != nil && util.IsFalseOrEmpty(n.config["ipv6.dhcp.stateful"]) && n.isRunning() {
+		neigh := &ip.Neigh{DevName: n.name}
+		neighbours, err := neigh.Show()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, neighbour := range neighbours {
+			if neighbour.Addr.To4() != nil || !neighbour.Addr.IsGlobalUnicast() || !netIP6.Contains(neighbour.Addr) {
+				continue
+			}
+
+			if neighbour.MAC == nil || neighbour.State == ip.NeighbourIPStateFailed || neighbour.State == ip.NeighbourIPStateIncomplete {
+				continue
+			}
+
+			// Skip the neighbours belonging to instances from other projects.
+			macStr := neighbour.MAC.String()
+			if clientType == request.ClientTypeNormal && !slices.Contains(projectMacs, macStr) {
+				continue
+			}
+
+			// Skip addresses already known through another record (such as the predicted EUI64 addresses).
+			found := false
+			for _, entry := range leases {
+				if net.ParseIP(entry.Address).Equal(neighbour.Addr) {
+					found = true
+					break
+				}
+			}
+
+			if found {
+				continue
+			}
+
+			leases = append(leases, api.NetworkLease{
+				Address:  neighbour.Addr.String(),
+				Hwaddr:   macStr,
+				Type:     "slaac",
+				Location: n.state.ServerName,
+			})
+		}
+	}
+
 	// Get dynamic leases.
 	leaseFile := internalUtil.VarPath("networks", n.name, "dnsmasq.leases")
 	if !util.PathExists(leaseFile) {
@@ -3312,6 +4063,17 @@ func (n *bridge) Leases(projectName string, clientType request.ClientType) ([]ap
 		return nil, err
 	}
 
+	// Get the leases imported from an external dnsmasq, ignoring those which expired or were replaced since.
+	var importedLeases []string
+	if projectName == n.project {
+		importedContent, err := os.ReadFile(internalUtil.VarPath("networks", n.name, "dnsmasq.leases.imported"))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+
+		importedLeases = bridgeImportedLeases(string(importedContent), string(content))
+	}
+
 	for _, lease := range strings.Split(string(content), "\n") {
 		fields := strings.Fields(lease)
 		if len(fields) >= 5 {
@@ -3345,7 +4107,8 @@ func (n *bridge) Leases(projectName string, clientType request.ClientType) ([]ap
 			// Skip leases that don't match any of the instance MACs from the project (only when we
 			// have populated the projectMacs list in ClientTypeNormal mode). Otherwise get all local
 			// leases and they will be filtered on the server handling the end user request.
-			if clientType == request.ClientTypeNormal && macStr != "" && !slices.Contains(projectMacs, macStr) {
+			// Leases imported from an external dnsmasq are kept as they don't belong to any instance.
+			if clientType == request.ClientTypeNormal && macStr != "" && !slices.Contains(projectMacs, macStr) && !slices.Contains(importedLeases, fields[1]+" "+fields[2]) {
 				continue
 			}
 
@@ -3375,9 +4138,16 @@ func (n *bridge) Leases(projectName string, clientType request.ClientType) ([]ap
 
 			// Add local leases from other members, filtering them for MACs that belong to the project.
 			for _, lease := range memberLeases {
-				if lease.Hwaddr != "" && slices.Contains(projectMacs, lease.Hwaddr) {
-					leases = append(leases, lease)
+				if lease.Hwaddr == "" || !slices.Contains(projectMacs, lease.Hwaddr) {
+					continue
+				}
+
+				// Skip SLAAC addresses already covered by the predicted EUI64 addresses.
+				if lease.Type == "slaac" && slices.ContainsFunc(leases, func(entry api.NetworkLease) bool { return entry.Address == lease.Address }) {
+					continue
 				}
+
+				leases = append(leases, lease)
 			}
 
 			return nil
@@ -3390,6 +4160,51 @@ func (n *bridge) Leases(projectName string, clientType request.ClientType) ([]ap
 	return leases, nil
 }
 
+// Stats returns the traffic counters of the bridge and of the local instance devices connected to it.
+func (n *bridge) Stats(projectName string) (*api.NetworkStats, error) {
+	if !n.isRunning() {
+		return nil, api.StatusErrorf(http.StatusBadRequest, "The network isn't running")
+	}
+
+	counters, err := resources.GetNetworkCounters(n.name)
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting network counters: %w", err)
+	}
+
+	stats := &api.NetworkStats{
+		Counters:  *counters,
+		Instances: []api.NetworkStatsInstance{},
+	}
+
+	filter := dbCluster.InstanceFilter{Project: &projectName, Node: &n.state.ServerName}
+	err = UsedByInstanceDevices(n.state, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
+		// Skip devices of stopped instances.
+		hostName := inst.Config[fmt.Sprintf("volatile.%s.host_name", nicName)]
+		if !InterfaceExists(hostName) {
+			return nil
+		}
+
+		hostCounters, err := resources.GetNetworkCounters(hostName)
+		if err != nil {
+			return fmt.Errorf("Failed getting counters of %q: %w", hostName, err)
+		}
+
+		stats.Instances = append(stats.Instances, api.NetworkStatsInstance{
+			Name:     inst.Name,
+			Project:  inst.Project,
+			Device:   nicName,
+			Counters: instanceCounters(*hostCounters),
+		})
+
+		return nil
+	}, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
 // UsesDNSMasq indicates if network's config indicates if it needs to use dnsmasq.
 func (n *bridge) UsesDNSMasq() bool {
 	// Skip dnsmasq when no connectivity is configured.
@@ -0,0 +1,73 @@
+package network
+
+import (
+	"fmt"
+	"net"
+)
+
+func Example_bridgeMergeLeases() {
+	_, subnetV4, _ := net.ParseCIDR("10.0.0.0/24")
+	_, subnetV6, _ := net.ParseCIDR("fd42::/64")
+
+	existing := "1700000000 00:16:3e:00:00:01 10.0.0.10 c1 01:00:16:3e:00:00:01\n"
+	content := `1700000000 00:16:3e:00:00:02 10.0.0.10 taken *
+1700000000 00:16:3e:00:00:03 10.0.0.11 kept *
+1700000000 00:16:3e:00:00:04 192.0.2.1 outside *
+1700000000 not-a-mac 10.0.0.12 invalid *
+1700000000 12345678 fd42::12 kept6 00:01:00:01
+incomplete line`
+
+	leases, newLines := bridgeMergeLeases(existing, content, subnetV4, subnetV6)
+	fmt.Print(leases)
+	fmt.Println(len(newLines))
+
+	// Nothing is imported when DHCP is disabled for the family.
+	_, newLines = bridgeMergeLeases(existing, content, subnetV4, nil)
+	fmt.Println(len(newLines))
+
+	// Output: 1700000000 00:16:3e:00:00:01 10.0.0.10 c1 01:00:16:3e:00:00:01
+	// 1700000000 00:16:3e:00:00:03 10.0.0.11 kept *
+	// 1700000000 12345678 fd42::12 kept6 00:01:00:01
+	// 2
+	// 1
+}
+
+func Example_bridgeImportedLeases() {
+	leases := `1700000000 00:16:3e:00:00:03 10.0.0.11 kept *
+1700000000 00:16:3e:00:00:09 10.0.0.12 replaced *
+`
+
+	imported := `00:16:3e:00:00:03 10.0.0.11
+00:16:3e:00:00:04 10.0.0.12
+00:16:3e:00:00:05 10.0.0.13
+00:16:3e:00:00:03 10.0.0.11
+10.0.0.14
+`
+
+	for _, entry := range bridgeImportedLeases(imported, leases) {
+		fmt.Println(entry)
+	}
+
+	// Output: 00:16:3e:00:00:03 10.0.0.11
+}
+
+func Example_bridgeDeleteLease() {
+	leases := `1700000000 00:16:3e:00:00:01 10.0.0.10 c1 *
+1700000000 00:16:3e:00:00:02 10.0.0.11 c2 *
+`
+
+	leases, found := bridgeDeleteLease(leases, net.ParseIP("10.0.0.10"))
+	fmt.Print(leases)
+	fmt.Println(found)
+
+	leases, found = bridgeDeleteLease(leases, net.ParseIP("10.0.0.10"))
+	fmt.Println(found)
+
+	leases, found = bridgeDeleteLease(leases, net.ParseIP("10.0.0.11"))
+	fmt.Printf("%q %v\n", leases, found)
+
+	// Output: 1700000000 00:16:3e:00:00:02 10.0.0.11 c2 *
+	// true
+	// false
+	// "" true
+}
@@ -10,6 +10,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
 
 	incus "github.com/lxc/incus/v6/client"
@@ -96,6 +97,8 @@ type common struct {
 	status      string
 	managed     bool
 	nodes       map[int64]db.NetworkNode
+	createdAt   time.Time
+	uuid        string
 }
 
 // init initialize internal variables.
@@ -111,6 +114,8 @@ func (n *common) init(s *state.State, id int64, projectName string, netInfo *api
 	n.status = netInfo.Status
 	n.managed = netInfo.Managed
 	n.nodes = netNodes
+	n.createdAt = netInfo.CreatedAt
+	n.uuid = netInfo.UUID
 
 	return nil
 }
@@ -122,7 +127,48 @@ func (n *common) FillConfig(config map[string]string) error {
 
 // validationRules returns a map of config rules common to all drivers.
 func (n *common) validationRules() map[string]func(string) error {
-	return map[string]func(string) error{}
+	return map[string]func(string) error{
+		// gendoc:generate(entity=network_bridge, group=common, key=startup.priority)
+		// Networks are always started after the managed networks they depend on (parent interface or uplink
+		// network). Otherwise, networks with a higher value are started first.
+		// ---
+		//  type: integer
+		//  defaultdesc: `0`
+		//  shortdesc: Startup priority of the network
+
+		// gendoc:generate(entity=network_macvlan, group=common, key=startup.priority)
+		// Networks are always started after the managed networks they depend on (parent interface or uplink
+		// network). Otherwise, networks with a higher value are started first.
+		// ---
+		//  type: integer
+		//  defaultdesc: `0`
+		//  shortdesc: Startup priority of the network
+
+		// gendoc:generate(entity=network_ovn, group=common, key=startup.priority)
+		// Networks are always started after the managed networks they depend on (parent interface or uplink
+		// network). Otherwise, networks with a higher value are started first.
+		// ---
+		//  type: integer
+		//  defaultdesc: `0`
+		//  shortdesc: Startup priority of the network
+
+		// gendoc:generate(entity=network_physical, group=common, key=startup.priority)
+		// Networks are always started after the managed networks they depend on (parent interface or uplink
+		// network). Otherwise, networks with a higher value are started first.
+		// ---
+		//  type: integer
+		//  defaultdesc: `0`
+		//  shortdesc: Startup priority of the network
+
+		// gendoc:generate(entity=network_sriov, group=common, key=startup.priority)
+		// Networks are always started after the managed networks they depend on (parent interface or uplink
+		// network). Otherwise, networks with a higher value are started first.
+		// ---
+		//  type: integer
+		//  defaultdesc: `0`
+		//  shortdesc: Startup priority of the network
+		"startup.priority": validate.Optional(validate.IsInt64),
+	}
 }
 
 // validate a network config against common rules and optional driver specific rules.
@@ -135,12 +181,13 @@ func (n *common) validate(config map[string]string, driverRules map[string]func(
 	// Merge driver specific rules into common rules.
 	maps.Copy(rules, driverRules)
 
-	// Run the validator against each field.
-	for k, validator := range rules {
+	// Run the validator against each field, collecting the failures of all the fields.
+	var errs ConfigKeyErrors
+	for _, k := range slices.Sorted(maps.Keys(rules)) {
 		checkedFields[k] = struct{}{} // Mark field as checked.
-		err := validator(config[k])
+		err := rules[k](config[k])
 		if err != nil {
-			return fmt.Errorf("Invalid value for network %q option %q: %w", n.name, k, err)
+			errs = append(errs, ConfigKeyError{Key: k, Err: fmt.Errorf("Invalid value for network %q option %q: %w", n.name, k, err)})
 		}
 	}
 
@@ -156,7 +203,13 @@ func (n *common) validate(config map[string]string, driverRules map[string]func(
 			continue
 		}
 
-		return fmt.Errorf("Invalid option for network %q option %q", n.name, k)
+		errs = append(errs, ConfigKeyError{Key: k, Err: fmt.Errorf("Invalid option for network %q option %q", n.name, k)})
+	}
+
+	if len(errs) > 0 {
+		slices.SortFunc(errs, func(a ConfigKeyError, b ConfigKeyError) int { return strings.Compare(a.Key, b.Key) })
+
+		return errs
 	}
 
 	return nil
@@ -256,6 +309,16 @@ func (n *common) Description() string {
 	return n.description
 }
 
+// CreatedAt returns the network creation timestamp.
+func (n *common) CreatedAt() time.Time {
+	return n.createdAt
+}
+
+// UUID returns the stable identifier of the network.
+func (n *common) UUID() string {
+	return n.uuid
+}
+
 // Status returns the network status.
 func (n *common) Status() string {
 	return n.status
@@ -295,6 +358,11 @@ func (n *common) Info() Info {
 	}
 }
 
+// RestartKeys returns the config keys whose change requires restarting the network, by default none.
+func (n *common) RestartKeys() []string {
+	return []string{}
+}
+
 // Locations returns the list of cluster members this network is configured on.
 func (n *common) Locations() []string {
 	locations := make([]string, 0, len(n.nodes))
@@ -305,6 +373,16 @@ func (n *common) Locations() []string {
 	return locations
 }
 
+// LocationStatus returns the state of the network on each cluster member it's defined on.
+func (n *common) LocationStatus() map[string]string {
+	status := make(map[string]string, len(n.nodes))
+	for _, netNode := range n.nodes {
+		status[netNode.Name] = db.NetworkStateToAPIStatus(netNode.State)
+	}
+
+	return status
+}
+
 // IsUsed returns whether the network is in use by instances or by downstream networks.
 func (n *common) IsUsed(instanceOnly bool) (bool, error) {
 	if instanceOnly {
@@ -395,11 +473,9 @@ func (n *common) update(applyNetwork api.NetworkPut, targetNode string, clientTy
 				return err
 			}
 
-			sendNetwork := applyNetwork
-			sendNetwork.Config = make(map[string]string)
-
 			// Don't forward node specific keys (these will be merged in on recipient node).
-			applyNetwork.Config = db.StripNodeSpecificNetworkConfig(applyNetwork.Config)
+			sendNetwork := applyNetwork
+			sendNetwork.Config = db.StripNodeSpecificNetworkConfig(applyNetwork.Config)
 
 			err = notifier(func(client incus.InstanceServer) error {
 				return client.UseProject(n.project).UpdateNetwork(n.name, sendNetwork, "")
@@ -1040,6 +1116,31 @@ func (n *common) forwardValidate(listenAddress net.IP, forward *api.NetworkForwa
 	return portMaps, err
 }
 
+// PortBindings returns ErrNotImplemented for drivers that do not enforce port bindings.
+func (n *common) PortBindings(projectName string) ([]api.NetworkPortBinding, error) {
+	return nil, ErrNotImplemented
+}
+
+// QoS returns ErrNotImplemented for drivers that do not apply traffic limits to their ports.
+func (n *common) QoS() ([]api.NetworkStateQoSPort, error) {
+	return nil, ErrNotImplemented
+}
+
+// Stats returns ErrNotImplemented for drivers that do not report traffic statistics.
+func (n *common) Stats(projectName string) (*api.NetworkStats, error) {
+	return nil, ErrNotImplemented
+}
+
+// ImportLeases returns ErrNotImplemented for drivers that do not manage DHCP leases.
+func (n *common) ImportLeases(content string) (int, error) {
+	return 0, ErrNotImplemented
+}
+
+// DeleteLease returns ErrNotImplemented for drivers that do not manage DHCP leases.
+func (n *common) DeleteLease(address string) error {
+	return ErrNotImplemented
+}
+
 // ForwardCreate returns ErrNotImplemented for drivers that do not support forwards.
 func (n *common) ForwardCreate(forward api.NetworkForwardsPost, clientType request.ClientType) error {
 	return ErrNotImplemented
@@ -1649,6 +1750,32 @@ func (n *common) State() (*api.NetworkState, error) {
 	return resources.GetNetworkState(n.name)
 }
 
+// ipv6RouterAdvertisements returns whether the network sends IPv6 router advertisements, nil without IPv6.
+func (n *common) ipv6RouterAdvertisements() *bool {
+	if util.IsNoneOrEmpty(n.config["ipv6.address"]) {
+		return nil
+	}
+
+	enabled := util.IsTrueOrEmpty(n.config["ipv6.ra"])
+
+	return &enabled
+}
+
+// Render returns the driver artifacts resulting from the network's config, by default there are none.
+func (n *common) Render() (map[string]string, error) {
+	return map[string]string{}, nil
+}
+
+// CheckDataplane checks that the parent interface used by the network exists on this member.
+func (n *common) CheckDataplane() error {
+	parent := n.config["parent"]
+	if parent != "" && !InterfaceExists(parent) {
+		return fmt.Errorf("Parent interface %q not found", parent)
+	}
+
+	return nil
+}
+
 func (n *common) setUnavailable() {
 	pn := ProjectNetwork{
 		ProjectName: n.Project(),
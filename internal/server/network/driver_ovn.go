@@ -2,11 +2,13 @@ package network
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"maps"
+	"math"
 	"math/big"
 	"net"
 	"net/http"
@@ -124,6 +126,82 @@ func (n *ovn) init(s *state.State, id int64, projectName string, netInfo *api.Ne
 	return n.common.init(s, id, projectName, netInfo, netNodes)
 }
 
+// CheckDataplane checks that the OVN logical router and switch of the network exist.
+func (n *ovn) CheckDataplane() error {
+	// Networks with no uplink and no IP addresses don't have a router.
+	if n.config["network"] != "none" || n.config["ipv4.address"] != "none" || n.config["ipv6.address"] != "none" {
+		_, err := n.ovnnb.GetLogicalRouter(context.TODO(), n.getRouterName())
+		if err != nil {
+			if errors.Is(err, networkOVN.ErrNotFound) {
+				return fmt.Errorf("OVN logical router %q not found", n.getRouterName())
+			}
+
+			return fmt.Errorf("Failed getting OVN logical router %q: %w", n.getRouterName(), err)
+		}
+	}
+
+	_, err := n.ovnnb.GetLogicalSwitch(context.TODO(), n.getIntSwitchName())
+	if err != nil {
+		if errors.Is(err, networkOVN.ErrNotFound) {
+			return fmt.Errorf("OVN logical switch %q not found", n.getIntSwitchName())
+		}
+
+		return fmt.Errorf("Failed getting OVN logical switch %q: %w", n.getIntSwitchName(), err)
+	}
+
+	return nil
+}
+
+// Render returns the definitions of the OVN objects resulting from the network's current config, without applying it.
+func (n *ovn) Render() (map[string]string, error) {
+	routerMAC, err := n.getRouterMAC()
+	if err != nil {
+		return nil, err
+	}
+
+	routerExtMAC, err := n.getRouterExtMAC()
+	if err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+
+	hasRouter := n.config["network"] != "none" || n.config["ipv4.address"] != "none" || n.config["ipv6.address"] != "none"
+	if hasRouter {
+		fmt.Fprintf(&b, "logical_router %s\n", n.getRouterName())
+	}
+
+	if n.config["network"] != "none" {
+		extIPs := []string{}
+		for _, k := range []string{ovnVolatileUplinkIPv4, ovnVolatileUplinkIPv6} {
+			if n.config[k] != "" {
+				extIPs = append(extIPs, n.config[k])
+			}
+		}
+
+		fmt.Fprintf(&b, "logical_switch %s\n", n.getExtSwitchName())
+		fmt.Fprintf(&b, "logical_switch_port %s switch=%s\n", n.getExtSwitchProviderPortName(), n.getExtSwitchName())
+		fmt.Fprintf(&b, "logical_switch_port %s switch=%s router_port=%s\n", n.getExtSwitchRouterPortName(), n.getExtSwitchName(), n.getRouterExtPortName())
+		fmt.Fprintf(&b, "logical_router_port %s router=%s mac=%s networks=%s chassis_group=%s\n", n.getRouterExtPortName(), n.getRouterName(), routerExtMAC, strings.Join(extIPs, ","), n.getChassisGroupName())
+	}
+
+	fmt.Fprintf(&b, "logical_switch %s\n", n.getIntSwitchName())
+
+	if hasRouter {
+		intIPs := []string{}
+		for _, ipNet := range []string{n.getRouterIntPortIPv4Net(), n.getRouterIntPortIPv6Net()} {
+			if !util.IsNoneOrEmpty(ipNet) {
+				intIPs = append(intIPs, ipNet)
+			}
+		}
+
+		fmt.Fprintf(&b, "logical_switch_port %s switch=%s router_port=%s\n", n.getIntSwitchRouterPortName(), n.getIntSwitchName(), n.getRouterIntPortName())
+		fmt.Fprintf(&b, "logical_router_port %s router=%s mac=%s networks=%s\n", n.getRouterIntPortName(), n.getRouterName(), routerMAC, strings.Join(intIPs, ","))
+	}
+
+	return map[string]string{"ovn.objects": b.String()}, nil
+}
+
 // DBType returns the network type DB ID.
 func (n *ovn) DBType() db.NetworkType {
 	return db.NetworkTypeOVN
@@ -141,6 +219,11 @@ func (n *ovn) Info() Info {
 	return info
 }
 
+// RestartKeys returns the config keys whose change requires restarting the network.
+func (n *ovn) RestartKeys() []string {
+	return []string{"network"}
+}
+
 func (n *ovn) State() (*api.NetworkState, error) {
 	// Get the addresses.
 	var addresses []api.NetworkStateAddress
@@ -214,6 +297,11 @@ func (n *ovn) State() (*api.NetworkState, error) {
 		mtu = 1500
 	}
 
+	topology, err := n.topology(logicalRouterName, logicalSwitchName, chassis)
+	if err != nil {
+		return nil, err
+	}
+
 	return &api.NetworkState{
 		Addresses: addresses,
 		Hwaddr:    hwaddr,
@@ -226,10 +314,72 @@ func (n *ovn) State() (*api.NetworkState, error) {
 			LogicalSwitch: string(logicalSwitchName),
 			UplinkIPv4:    uplinkIPv4,
 			UplinkIPv6:    uplinkIPv6,
+			Topology:      topology,
 		},
+		IPv6RouterAdvertisements: n.ipv6RouterAdvertisements(),
 	}, nil
 }
 
+// topology returns the OVN logical switch and router ports of the network along with its uplink binding.
+func (n *ovn) topology(logicalRouterName networkOVN.OVNRouter, logicalSwitchName networkOVN.OVNSwitch, chassis string) (*api.NetworkStateOVNTopology, error) {
+	topology := &api.NetworkStateOVNTopology{
+		LogicalSwitchPorts: []api.NetworkStateOVNLogicalSwitchPort{},
+		LogicalRouterPorts: []api.NetworkStateOVNLogicalRouterPort{},
+	}
+
+	lsps, err := n.ovnnb.GetLogicalSwitchPortRecords(context.TODO(), logicalSwitchName)
+	if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+		return nil, fmt.Errorf("Failed getting logical switch ports: %w", err)
+	}
+
+	for _, lsp := range lsps {
+		addresses := slices.Clone(lsp.Addresses)
+		if lsp.DynamicAddresses != nil {
+			addresses = append(addresses, *lsp.DynamicAddresses)
+		}
+
+		topology.LogicalSwitchPorts = append(topology.LogicalSwitchPorts, api.NetworkStateOVNLogicalSwitchPort{
+			Name:      lsp.Name,
+			Type:      lsp.Type,
+			Addresses: addresses,
+			Up:        lsp.Up != nil && *lsp.Up,
+		})
+	}
+
+	if logicalRouterName != "" {
+		lrps, err := n.ovnnb.GetLogicalRouterPortRecords(context.TODO(), logicalRouterName)
+		if err != nil && !errors.Is(err, networkOVN.ErrNotFound) {
+			return nil, fmt.Errorf("Failed getting logical router ports: %w", err)
+		}
+
+		for _, lrp := range lrps {
+			topology.LogicalRouterPorts = append(topology.LogicalRouterPorts, api.NetworkStateOVNLogicalRouterPort{
+				Name:     lrp.Name,
+				MAC:      lrp.MAC,
+				Networks: lrp.Networks,
+			})
+		}
+	}
+
+	if n.config["network"] != "none" {
+		topology.UplinkBinding = &api.NetworkStateOVNUplinkBinding{
+			Network: n.config["network"],
+			Port:    string(n.getRouterExtPortName()),
+			Chassis: chassis,
+		}
+	}
+
+	slices.SortFunc(topology.LogicalSwitchPorts, func(a api.NetworkStateOVNLogicalSwitchPort, b api.NetworkStateOVNLogicalSwitchPort) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	slices.SortFunc(topology.LogicalRouterPorts, func(a api.NetworkStateOVNLogicalRouterPort, b api.NetworkStateOVNLogicalRouterPort) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return topology, nil
+}
+
 // uplinkRoutes parses ipv4.routes and ipv6.routes settings for an uplink network into a slice of *net.IPNet.
 func (n *ovn) uplinkRoutes(uplink *api.Network) ([]*net.IPNet, error) {
 	var err error
@@ -346,6 +496,79 @@ func (n *ovn) validateExternalSubnet(uplink *api.Network, projectRestrictedSubne
 	return api.StatusErrorf(http.StatusBadRequest, "Uplink network doesn't contain %q in its routes", ipNet.String())
 }
 
+// validateUplinkAddresses checks that the uplink addresses set in the supplied config (when new or changed) are
+// within the uplink's OVN ranges and aren't already used by another OVN network on the same uplink.
+func (n *ovn) validateUplinkAddresses(uplink *api.Network, config map[string]string) error {
+	for _, key := range []string{ovnVolatileUplinkIPv4, ovnVolatileUplinkIPv6} {
+		if config[key] == "" || (n.status == api.NetworkStatusCreated && config[key] == n.config[key]) {
+			continue
+		}
+
+		ip := net.ParseIP(config[key])
+		if ip == nil {
+			return fmt.Errorf("Invalid %q value %q", key, config[key])
+		}
+
+		rangesKey := "ipv4.ovn.ranges"
+		if ip.To4() == nil {
+			rangesKey = "ipv6.ovn.ranges"
+		}
+
+		if uplink.Config[rangesKey] != "" {
+			ipRanges, err := parseIPRanges(uplink.Config[rangesKey])
+			if err != nil {
+				return fmt.Errorf("Failed parsing uplink %q: %w", rangesKey, err)
+			}
+
+			inRange := false
+			for _, ipRange := range ipRanges {
+				if ipRange.ContainsIP(ip) {
+					inRange = true
+					break
+				}
+			}
+
+			if !inRange {
+				return fmt.Errorf("Uplink address %q (%s) isn't within the uplink network's %q", ip.String(), key, rangesKey)
+			}
+		} else if ip.To4() != nil {
+			return fmt.Errorf("Cannot set %q as the uplink network doesn't have %q configured", key, rangesKey)
+		}
+
+		var projectNetworks map[string]map[int64]api.Network
+		err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+			var err error
+			projectNetworks, err = tx.GetCreatedNetworks(ctx)
+
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("Failed loading networks: %w", err)
+		}
+
+		for projectName, networks := range projectNetworks {
+			for _, netInfo := range networks {
+				if netInfo.Type != "ovn" || netInfo.Config["network"] != uplink.Name {
+					continue
+				}
+
+				if projectName == n.project && netInfo.Name == n.name {
+					continue
+				}
+
+				otherIP := net.ParseIP(netInfo.Config[key])
+				if otherIP != nil && otherIP.Equal(ip) {
+					// This error is purposefully vague so that it doesn't reveal any names of
+					// resources potentially outside of the network's project.
+					return fmt.Errorf("Uplink address %q (%s) is already used by another OVN network", ip.String(), key)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 // getExternalSubnetInUse returns information about usage of external subnets by networks and NICs connected to,
 // or used by, the specified uplinkNetworkName.
 func (n *ovn) getExternalSubnetInUse(uplinkNetworkName string) ([]externalSubnetUsage, error) {
@@ -510,6 +733,15 @@ func (n *ovn) Validate(config map[string]string) error {
 		//  default: `false`
 		"ipv6.dhcp.stateful": validate.Optional(validate.IsBool),
 
+		// gendoc:generate(entity=network_ovn, group=common, key=ipv6.ra)
+		//
+		// ---
+		//  type: bool
+		//  condition: IPv6 address
+		//  default: `true`
+		//  shortdesc: Whether to send router advertisements (disable when an external router advertises the subnet)
+		"ipv6.ra": validate.Optional(validate.IsBool),
+
 		// gendoc:generate(entity=network_ovn, group=common, key=ipv4.nat)
 		//
 		// ---
@@ -606,6 +838,22 @@ func (n *ovn) Validate(config map[string]string) error {
 		//  shortdesc: DNS zone name for IPv6 reverse DNS records
 		"dns.zone.reverse.ipv6": validate.IsAny,
 
+		// gendoc:generate(entity=network_ovn, group=common, key=router.port.name)
+		// Use a fixed name for the logical router port connecting the network to its uplink rather than the
+		// generated one, so that external routing configuration can reference it.
+		// Can only be set when creating the network.
+		// ---
+		//  type: string
+		//  shortdesc: Name of the uplink facing logical router port
+		"router.port.name": validate.Optional(validate.IsDeviceName),
+
+		// gendoc:generate(entity=network_ovn, group=common, key=router.port.hwaddr)
+		// Can only be set when creating the network.
+		// ---
+		//  type: string
+		//  shortdesc: MAC address of the uplink facing logical router port (defaults to the router MAC address)
+		"router.port.hwaddr": validate.Optional(validate.IsNetworkMAC),
+
 		// gendoc:generate(entity=network_ovn, group=common, key=security.acls)
 		//
 		// ---
@@ -722,7 +970,7 @@ func (n *ovn) Validate(config map[string]string) error {
 	var uplink *api.Network
 	projectRestrictedSubnets := []*net.IPNet{}
 
-	if n.config["network"] != "none" {
+	if config["network"] != "none" {
 		uplinkNetworkName, err := n.validateUplinkNetwork(p, config["network"])
 		if err != nil {
 			return err
@@ -778,6 +1026,12 @@ func (n *ovn) Validate(config map[string]string) error {
 		return nil
 	}
 
+	// Check any requested uplink addresses can be used by this network.
+	err = n.validateUplinkAddresses(uplink, config)
+	if err != nil {
+		return err
+	}
+
 	// If NAT disabled, parse the external subnets that are being requested.
 	var externalSubnets []*net.IPNet // Subnets to check for conflicts with other networks/NICs.
 	for _, keyPrefix := range []string{"ipv4", "ipv6"} {
@@ -1100,6 +1354,10 @@ func (n *ovn) getRouterName() networkOVN.OVNRouter {
 
 // getRouterExtPortName returns OVN logical router external port name to use.
 func (n *ovn) getRouterExtPortName() networkOVN.OVNRouterPort {
+	if n.config["router.port.name"] != "" {
+		return networkOVN.OVNRouterPort(n.config["router.port.name"])
+	}
+
 	return networkOVN.OVNRouterPort(fmt.Sprintf("%s-lrp-ext", n.getRouterName()))
 }
 
@@ -1108,6 +1366,20 @@ func (n *ovn) getRouterIntPortName() networkOVN.OVNRouterPort {
 	return networkOVN.OVNRouterPort(fmt.Sprintf("%s-lrp-int", n.getRouterName()))
 }
 
+// getRouterExtMAC returns the MAC address to use for the OVN router external port.
+func (n *ovn) getRouterExtMAC() (net.HardwareAddr, error) {
+	if n.config["router.port.hwaddr"] != "" {
+		mac, err := net.ParseMAC(n.config["router.port.hwaddr"])
+		if err != nil {
+			return nil, fmt.Errorf("Failed parsing router port MAC address %q: %w", n.config["router.port.hwaddr"], err)
+		}
+
+		return mac, nil
+	}
+
+	return n.getRouterMAC()
+}
+
 // getRouterMAC returns OVN router MAC address to use for ports. Uses a stable seed to return stable random MAC.
 func (n *ovn) getRouterMAC() (net.HardwareAddr, error) {
 	hwAddr := n.config["bridge.hwaddr"]
@@ -1362,7 +1634,7 @@ func (n *ovn) allocateUplinkPortIPs(uplinkNet Network, routerMAC net.HardwareAdd
 	// Decide whether we need to allocate new IP(s) and go to the expense of retrieving all allocated IPs.
 	if (uplinkIPv4Net != nil && routerExtPortIPv4 == nil) || (uplinkIPv6Net != nil && routerExtPortIPv6 == nil) {
 		err := n.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			allAllocatedIPv4, allAllocatedIPv6, err := n.uplinkAllAllocatedIPs(ctx, tx, uplinkNet.Name())
+			allAllocatedIPv4, allAllocatedIPv6, err := uplinkAllAllocatedIPs(ctx, tx, uplinkNet.Name())
 			if err != nil {
 				return fmt.Errorf("Failed to get all allocated IPs for uplink: %w", err)
 			}
@@ -1461,7 +1733,7 @@ func (n *ovn) allocateUplinkPortIPs(uplinkNet Network, routerMAC net.HardwareAdd
 }
 
 // uplinkAllAllocatedIPs gets a list of all IPv4 and IPv6 addresses allocated to OVN networks connected to uplink.
-func (n *ovn) uplinkAllAllocatedIPs(ctx context.Context, tx *db.ClusterTx, uplinkNetName string) ([]net.IP, []net.IP, error) {
+func uplinkAllAllocatedIPs(ctx context.Context, tx *db.ClusterTx, uplinkNetName string) ([]net.IP, []net.IP, error) {
 	// Get all managed networks across all projects.
 	projectNetworks, err := tx.GetCreatedNetworks(ctx)
 	if err != nil {
@@ -1495,6 +1767,112 @@ func (n *ovn) uplinkAllAllocatedIPs(ctx context.Context, tx *db.ClusterTx, uplin
 	return v4IPs, v6IPs, nil
 }
 
+// OVNUplinkCapacity returns the number of free and total addresses in the uplink network's OVN ranges.
+// Returns nil if the uplink network doesn't have any OVN ranges configured.
+func OVNUplinkCapacity(s *state.State, uplinkNet Network) (*api.NetworkStateOVNUplinkCapacity, error) {
+	uplinkNetConf := uplinkNet.Config()
+	if uplinkNetConf["ipv4.ovn.ranges"] == "" && uplinkNetConf["ipv6.ovn.ranges"] == "" {
+		return nil, nil
+	}
+
+	var allAllocatedIPv4, allAllocatedIPv6 []net.IP
+	err := s.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+		allAllocatedIPv4, allAllocatedIPv6, err = uplinkAllAllocatedIPs(ctx, tx, uplinkNet.Name())
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get all allocated IPs for uplink: %w", err)
+	}
+
+	// rangesCapacity returns the free and total number of addresses in the ranges.
+	rangesCapacity := func(ranges string, allAllocated []net.IP) (uint64, uint64, error) {
+		if ranges == "" {
+			return 0, 0, nil
+		}
+
+		ipRanges, err := parseIPRanges(ranges)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		total := big.NewInt(0)
+		for _, ipRange := range ipRanges {
+			startBig := big.NewInt(0).SetBytes(ipRange.Start.To16())
+			endBig := big.NewInt(0).SetBytes(ipRange.End.To16())
+			total.Add(total, endBig.Sub(endBig, startBig).Add(endBig, big.NewInt(1)))
+		}
+
+		used := big.NewInt(0)
+		for _, ip := range allAllocated {
+			for _, ipRange := range ipRanges {
+				if ipRange.ContainsIP(ip) {
+					used.Add(used, big.NewInt(1))
+					break
+				}
+			}
+		}
+
+		free := big.NewInt(0).Sub(total, used)
+		if free.Sign() < 0 {
+			free.SetInt64(0)
+		}
+
+		// Saturate rather than overflow on very large IPv6 ranges.
+		toUint64 := func(v *big.Int) uint64 {
+			if !v.IsUint64() {
+				return math.MaxUint64
+			}
+
+			return v.Uint64()
+		}
+
+		return toUint64(free), toUint64(total), nil
+	}
+
+	capacity := &api.NetworkStateOVNUplinkCapacity{}
+
+	capacity.IPv4Free, capacity.IPv4Total, err = rangesCapacity(uplinkNetConf["ipv4.ovn.ranges"], allAllocatedIPv4)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse uplink IPv4 OVN ranges: %w", err)
+	}
+
+	capacity.IPv6Free, capacity.IPv6Total, err = rangesCapacity(uplinkNetConf["ipv6.ovn.ranges"], allAllocatedIPv6)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse uplink IPv6 OVN ranges: %w", err)
+	}
+
+	return capacity, nil
+}
+
+// OVNUplinkCapacityCheck checks that the uplink network has a free address in its OVN ranges for each address
+// family a new OVN network would need to allocate from.
+func OVNUplinkCapacityCheck(s *state.State, uplinkNet Network) error {
+	capacity, err := OVNUplinkCapacity(s, uplinkNet)
+	if err != nil {
+		return err
+	}
+
+	if capacity == nil {
+		return nil
+	}
+
+	uplinkNetConf := uplinkNet.Config()
+
+	hasIPv4 := (uplinkNetConf["ipv4.address"] != "" && uplinkNetConf["ipv4.address"] != "none") || uplinkNetConf["ipv4.gateway"] != ""
+	if hasIPv4 && uplinkNetConf["ipv4.ovn.ranges"] != "" && capacity.IPv4Free == 0 {
+		return fmt.Errorf("Uplink address pool exhausted: no free IPv4 address left in %q of uplink network %q", "ipv4.ovn.ranges", uplinkNet.Name())
+	}
+
+	hasIPv6 := (uplinkNetConf["ipv6.address"] != "" && uplinkNetConf["ipv6.address"] != "none") || uplinkNetConf["ipv6.gateway"] != ""
+	if hasIPv6 && uplinkNetConf["ipv6.ovn.ranges"] != "" && capacity.IPv6Free == 0 {
+		return fmt.Errorf("Uplink address pool exhausted: no free IPv6 address left in %q of uplink network %q", "ipv6.ovn.ranges", uplinkNet.Name())
+	}
+
+	return nil
+}
+
 // uplinkAllocateIP allocates a free IP from one of the IP ranges.
 func (n *ovn) uplinkAllocateIP(ipRanges []*iprange.Range, allAllocated []net.IP) (net.IP, error) {
 	for _, ipRange := range ipRanges {
@@ -2401,8 +2779,13 @@ func (n *ovn) setup(update bool) error {
 		return err
 	}
 
+	routerExtMAC, err := n.getRouterExtMAC()
+	if err != nil {
+		return err
+	}
+
 	// Setup uplink port (do this first to check uplink is suitable).
-	uplinkNet, err := n.setupUplinkPort(routerMAC)
+	uplinkNet, err := n.setupUplinkPort(routerExtMAC)
 	if err != nil {
 		return err
 	}
@@ -2491,7 +2874,7 @@ func (n *ovn) setup(update bool) error {
 		}
 
 		// Create external router port.
-		err = n.ovnnb.CreateLogicalRouterPort(context.TODO(), n.getRouterName(), n.getRouterExtPortName(), routerMAC, bridgeMTU, extRouterIPs, n.getChassisGroupName(), update)
+		err = n.ovnnb.CreateLogicalRouterPort(context.TODO(), n.getRouterName(), n.getRouterExtPortName(), routerExtMAC, bridgeMTU, extRouterIPs, n.getChassisGroupName(), update)
 		if err != nil {
 			return fmt.Errorf("Failed adding external router port: %w", err)
 		}
@@ -3044,7 +3427,7 @@ func (n *ovn) setup(update bool) error {
 	}
 
 	// Set IPv6 router advertisement settings.
-	if routerIntPortIPv6Net != nil {
+	if routerIntPortIPv6Net != nil && util.IsTrueOrEmpty(n.config["ipv6.ra"]) {
 		adressMode := networkOVN.OVNIPv6AddressModeSLAAC
 		if dhcpV6Subnet != nil {
 			adressMode = networkOVN.OVNIPv6AddressModeDHCPStateless
@@ -3775,6 +4158,21 @@ func (n *ovn) Update(newNetwork api.NetworkPut, targetNode string, clientType re
 	}
 
 	if clientType == request.ClientTypeNotifier {
+		// Move the local uplink port over to the new uplink network.
+		if newNetwork.Config["network"] != n.config["network"] {
+			err = n.deleteUplinkPort()
+			if err != nil {
+				return err
+			}
+
+			n.config = newNetwork.Config
+
+			err = n.startUplinkPort()
+			if err != nil {
+				return err
+			}
+		}
+
 		// Reload BGP on notifications.
 		err = n.bgpSetup(nil)
 		if err != nil {
@@ -3805,6 +4203,13 @@ func (n *ovn) Update(newNetwork api.NetworkPut, targetNode string, clientType re
 		return n.common.update(newNetwork, targetNode, clientType)
 	}
 
+	// The router port identity is referenced externally so can't be changed once created.
+	for _, key := range []string{"router.port.name", "router.port.hwaddr"} {
+		if slices.Contains(changedKeys, key) {
+			return fmt.Errorf("Cannot change %q once the network is created", key)
+		}
+	}
+
 	reverter := revert.New()
 	defer reverter.Fail()
 
@@ -6551,6 +6956,161 @@ func (n *ovn) Leases(projectName string, clientType request.ClientType) ([]api.N
 	return leases, nil
 }
 
+// QoS returns the traffic limits applied to the ports of the network's internal switch.
+func (n *ovn) QoS() ([]api.NetworkStateQoSPort, error) {
+	ovnQoS, err := n.ovnnb.GetLogicalSwitchPortQoS(context.TODO(), n.getIntSwitchName())
+	if err != nil {
+		if errors.Is(err, networkOVN.ErrNotFound) {
+			return []api.NetworkStateQoSPort{}, nil
+		}
+
+		return nil, fmt.Errorf("Failed getting OVN switch port QoS: %w", err)
+	}
+
+	qos := make([]api.NetworkStateQoSPort, 0, len(ovnQoS))
+	for _, portQoS := range ovnQoS {
+		qos = append(qos, api.NetworkStateQoSPort{Name: string(portQoS.Name), Ingress: portQoS.Ingress, Egress: portQoS.Egress})
+	}
+
+	return qos, nil
+}
+
+// PortBindings returns the MAC and IP bindings of the instance ports on the network's internal switch.
+func (n *ovn) PortBindings(projectName string) ([]api.NetworkPortBinding, error) {
+	ovnBindings, err := n.ovnnb.GetLogicalSwitchPortBindings(context.TODO(), n.getIntSwitchName())
+	if err != nil {
+		return nil, fmt.Errorf("Failed getting OVN switch port bindings: %w", err)
+	}
+
+	// Map the port names to the instance devices in the requested project.
+	type portDevice struct {
+		instance string
+		device   string
+	}
+
+	portDevices := map[networkOVN.OVNSwitchPort]portDevice{}
+
+	filter := dbCluster.InstanceFilter{Project: &projectName}
+	err = UsedByInstanceDevices(n.state, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
+		instanceUUID := inst.Config["volatile.uuid"]
+		if instanceUUID == "" {
+			return nil
+		}
+
+		portDevices[n.getInstanceDevicePortName(instanceUUID, nicName)] = portDevice{instance: inst.Name, device: nicName}
+
+		return nil
+	}, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings := []api.NetworkPortBinding{}
+	for _, ovnBinding := range ovnBindings {
+		dev, ok := portDevices[ovnBinding.Name]
+		if !ok {
+			continue
+		}
+
+		binding := api.NetworkPortBinding{
+			Port:         string(ovnBinding.Name),
+			Instance:     dev.instance,
+			Device:       dev.device,
+			Addresses:    []string{},
+			PortSecurity: []string{},
+			Enforced:     len(ovnBinding.PortSecurity) > 0,
+			Status:       "down",
+			Location:     ovnBinding.Location,
+		}
+
+		if ovnBinding.Up {
+			binding.Status = "up"
+		}
+
+		if ovnBinding.MAC != nil {
+			binding.Hwaddr = ovnBinding.MAC.String()
+		}
+
+		for _, ip := range ovnBinding.IPs {
+			binding.Addresses = append(binding.Addresses, ip.String())
+		}
+
+		if ovnBinding.PortSecurity != nil {
+			binding.PortSecurity = ovnBinding.PortSecurity
+		}
+
+		bindings = append(bindings, binding)
+	}
+
+	slices.SortFunc(bindings, func(a api.NetworkPortBinding, b api.NetworkPortBinding) int {
+		return cmp.Or(strings.Compare(a.Instance, b.Instance), strings.Compare(a.Device, b.Device))
+	})
+
+	return bindings, nil
+}
+
+// Stats returns the traffic counters of the local instance ports on the network, as seen by OpenVSwitch.
+// The network counters are the sum of those of all the local instance ports, whichever their project, while
+// only the ports of instances from the requested project are detailed.
+func (n *ovn) Stats(projectName string) (*api.NetworkStats, error) {
+	vswitch, err := n.state.OVS()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to connect to OVS: %w", err)
+	}
+
+	stats := &api.NetworkStats{
+		Instances: []api.NetworkStatsInstance{},
+	}
+
+	filter := dbCluster.InstanceFilter{Node: &n.state.ServerName}
+	err = UsedByInstanceDevices(n.state, n.Project(), n.Name(), n.Type(), func(inst db.InstanceArgs, nicName string, nicConfig map[string]string) error {
+		// Skip devices of stopped instances.
+		hostName := inst.Config[fmt.Sprintf("volatile.%s.host_name", nicName)]
+		if hostName == "" {
+			return nil
+		}
+
+		ovsStats, err := vswitch.GetInterfaceStatistics(context.TODO(), hostName)
+		if err != nil {
+			if errors.Is(err, ovs.ErrNotFound) {
+				return nil
+			}
+
+			return fmt.Errorf("Failed getting OVS statistics of %q: %w", hostName, err)
+		}
+
+		hostCounters := api.NetworkStateCounters{
+			BytesReceived:   int64(ovsStats["rx_bytes"]),
+			BytesSent:       int64(ovsStats["tx_bytes"]),
+			PacketsReceived: int64(ovsStats["rx_packets"]),
+			PacketsSent:     int64(ovsStats["tx_packets"]),
+		}
+
+		stats.Counters.BytesReceived += hostCounters.BytesReceived
+		stats.Counters.BytesSent += hostCounters.BytesSent
+		stats.Counters.PacketsReceived += hostCounters.PacketsReceived
+		stats.Counters.PacketsSent += hostCounters.PacketsSent
+
+		if inst.Project != projectName {
+			return nil
+		}
+
+		stats.Instances = append(stats.Instances, api.NetworkStatsInstance{
+			Name:     inst.Name,
+			Project:  inst.Project,
+			Device:   nicName,
+			Counters: instanceCounters(hostCounters),
+		})
+
+		return nil
+	}, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
 // localPeerCreate creates a network peering with another local network.
 func (n *ovn) localPeerCreate(peer api.NetworkPeersPost) error {
 	ctx := context.TODO()
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"maps"
 	"net"
+	"net/http"
 	"slices"
 	"strconv"
 
@@ -30,6 +31,11 @@ func (n *physical) DBType() db.NetworkType {
 	return db.NetworkTypePhysical
 }
 
+// RestartKeys returns the config keys whose change requires restarting the network.
+func (n *physical) RestartKeys() []string {
+	return []string{"parent", "vlan"}
+}
+
 // Validate network config.
 func (n *physical) Validate(config map[string]string) error {
 	rules := map[string]func(value string) error{
@@ -215,8 +221,10 @@ func (n *physical) Validate(config map[string]string) error {
 	return nil
 }
 
-// checkParentUse checks if parent is already in use by another network or instance device.
-func (n *physical) checkParentUse(ourConfig map[string]string) (bool, error) {
+// checkParentUse checks if parent is already in use by another network and returns the name of the
+// conflicting network if so.
+// As the parent and VLAN are member specific, the check is performed against the local member's config.
+func (n *physical) checkParentUse(ourConfig map[string]string) (string, error) {
 	// Get all managed networks across all projects.
 	var err error
 	var projectNetworks map[string]map[int64]api.Network
@@ -226,7 +234,7 @@ func (n *physical) checkParentUse(ourConfig map[string]string) (bool, error) {
 		return err
 	})
 	if err != nil {
-		return false, fmt.Errorf("Failed to load all networks: %w", err)
+		return "", fmt.Errorf("Failed to load all networks: %w", err)
 	}
 
 	for projectName, networks := range projectNetworks {
@@ -244,13 +252,22 @@ func (n *physical) checkParentUse(ourConfig map[string]string) (bool, error) {
 				// If either network doesn't specify a vlan, or both specify same vlan,
 				// then we can't use this parent.
 				if (network.Config["vlan"] == "" || ourConfig["vlan"] == "") || network.Config["vlan"] == ourConfig["vlan"] {
-					return true, nil
+					return network.Name, nil
 				}
 			}
 		}
 	}
 
-	return false, nil
+	return "", nil
+}
+
+// parentUseError returns the error describing a conflicting use of the parent interface.
+func (n *physical) parentUseError(config map[string]string, conflictName string) error {
+	if config["vlan"] != "" {
+		return api.StatusErrorf(http.StatusConflict, "Parent interface %q with VLAN %q already in use by network %q", config["parent"], config["vlan"], conflictName)
+	}
+
+	return api.StatusErrorf(http.StatusConflict, "Parent interface %q already in use by network %q", config["parent"], conflictName)
 }
 
 // Create checks whether the referenced parent interface is used by other networks or instance devices, as we
@@ -258,16 +275,14 @@ func (n *physical) checkParentUse(ourConfig map[string]string) (bool, error) {
 func (n *physical) Create(clientType request.ClientType) error {
 	n.logger.Debug("Create", logger.Ctx{"clientType": clientType, "config": n.config})
 
-	// We only need to check in the database once, not on every clustered node.
-	if clientType == request.ClientTypeNormal {
-		inUse, err := n.checkParentUse(n.config)
-		if err != nil {
-			return err
-		}
+	// The parent and VLAN are member specific so check on every member rather than waiting for start up to fail.
+	conflictName, err := n.checkParentUse(n.config)
+	if err != nil {
+		return err
+	}
 
-		if inUse {
-			return fmt.Errorf("Parent interface %q in use by another network", n.config["parent"])
-		}
+	if conflictName != "" {
+		return n.parentUseError(n.config, conflictName)
 	}
 
 	return nil
@@ -443,22 +458,22 @@ func (n *physical) Update(newNetwork api.NetworkPut, targetNode string, clientTy
 
 	hostNameChanged := slices.Contains(changedKeys, "vlan") || slices.Contains(changedKeys, "parent")
 
-	// We only need to check in the database once, not on every clustered node.
-	if clientType == request.ClientTypeNormal {
-		if hostNameChanged {
+	if hostNameChanged {
+		// We only need to check instance usage in the database once, not on every clustered node.
+		if clientType == request.ClientTypeNormal {
 			isUsed, err := n.IsUsed(true)
 			if isUsed || err != nil {
 				return errors.New("Cannot update network parent interface when in use")
 			}
+		}
 
-			inUse, err := n.checkParentUse(newNetwork.Config)
-			if err != nil {
-				return err
-			}
+		conflictName, err := n.checkParentUse(newNetwork.Config)
+		if err != nil {
+			return err
+		}
 
-			if inUse {
-				return fmt.Errorf("Parent interface %q in use by another network", newNetwork.Config["parent"])
-			}
+		if conflictName != "" {
+			return n.parentUseError(newNetwork.Config, conflictName)
 		}
 	}
 
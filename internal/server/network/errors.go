@@ -9,3 +9,27 @@ var ErrUnknownDriver = errors.New("Unknown driver")
 
 // ErrNotImplemented is the "Not implemented" error.
 var ErrNotImplemented = errors.New("Not implemented")
+
+// ConfigKeyError is a validation failure of a given config key.
+type ConfigKeyError struct {
+	Key string
+	Err error
+}
+
+// Error returns the validation failure.
+func (e ConfigKeyError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e ConfigKeyError) Unwrap() error {
+	return e.Err
+}
+
+// ConfigKeyErrors lists the validation failures of all the invalid config keys, sorted by key.
+type ConfigKeyErrors []ConfigKeyError
+
+// Error returns the first validation failure.
+func (e ConfigKeyErrors) Error() string {
+	return e[0].Error()
+}
@@ -2,6 +2,7 @@ package network
 
 import (
 	"net"
+	"time"
 
 	"github.com/lxc/incus/v6/internal/iprange"
 	"github.com/lxc/incus/v6/internal/server/cluster"
@@ -33,12 +34,16 @@ type Network interface {
 	Name() string
 	Project() string
 	Description() string
+	CreatedAt() time.Time
+	UUID() string
 	Status() string
 	LocalStatus() string
 	Config() map[string]string
 	Locations() []string
+	LocationStatus() map[string]string
 	IsUsed(instanceOnly bool) (bool, error)
 	IsManaged() bool
+	RestartKeys() []string
 	DHCPv4Subnet() *net.IPNet
 	DHCPv6Subnet() *net.IPNet
 	DHCPv4Ranges() []iprange.Range
@@ -56,7 +61,14 @@ type Network interface {
 
 	// Status.
 	State() (*api.NetworkState, error)
+	CheckDataplane() error
+	Render() (map[string]string, error)
 	Leases(projectName string, clientType request.ClientType) ([]api.NetworkLease, error)
+	PortBindings(projectName string) ([]api.NetworkPortBinding, error)
+	QoS() ([]api.NetworkStateQoSPort, error)
+	Stats(projectName string) (*api.NetworkStats, error)
+	ImportLeases(content string) (int, error)
+	DeleteLease(address string) error
 
 	// Address Forwards.
 	ForwardCreate(forward api.NetworkForwardsPost, clientType request.ClientType) error
@@ -3,6 +3,8 @@ package network
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"sync"
 
 	"github.com/lxc/incus/v6/internal/server/db"
@@ -29,6 +31,11 @@ var (
 	unavailableNetworksMu = sync.Mutex{}
 )
 
+// Types returns the sorted list of supported network driver types.
+func Types() []string {
+	return slices.Sorted(maps.Keys(drivers))
+}
+
 // LoadByType loads a network by driver type.
 func LoadByType(driverType string) (Type, error) {
 	driverFunc, ok := drivers[driverType]
@@ -76,6 +83,23 @@ func LoadByName(s *state.State, projectName string, name string) (Network, error
 	return n, nil
 }
 
+// LoadTransient instantiates a network from the given info without it being in the database.
+// This is used to validate a network's config before it gets created.
+func LoadTransient(s *state.State, projectName string, netInfo *api.Network) (Network, error) {
+	driverFunc, ok := drivers[netInfo.Type]
+	if !ok {
+		return nil, ErrUnknownDriver
+	}
+
+	n := driverFunc()
+	err := n.init(s, -1, projectName, netInfo, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
 // PatchPreCheck checks if there are any unavailable networks.
 func PatchPreCheck() error {
 	unavailableNetworksMu.Lock()
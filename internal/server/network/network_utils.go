@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	cryptoRand "crypto/rand"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 	"math/big"
 	"math/rand"
 	"net"
+	"net/http"
 	"net/netip"
 	"os"
 	"slices"
@@ -279,6 +281,85 @@ func UsedBy(s *state.State, networkProjectName string, networkID int64, networkN
 	return usedBy, nil
 }
 
+// References holds the listen addresses of the forwards and load balancers of networks, keyed by network ID.
+type References struct {
+	Forwards      map[int64][]string
+	LoadBalancers map[int64][]string
+}
+
+// LoadReferences loads the forwards and load balancers of the network with the given ID, or of all networks if nil.
+func LoadReferences(ctx context.Context, tx *db.ClusterTx, networkID *int64) (*References, error) {
+	refs := &References{
+		Forwards:      map[int64][]string{},
+		LoadBalancers: map[int64][]string{},
+	}
+
+	forwards, err := cluster.GetNetworkForwards(ctx, tx.Tx(), cluster.NetworkForwardFilter{NetworkID: networkID})
+	if err != nil {
+		return nil, fmt.Errorf("Failed loading network forwards: %w", err)
+	}
+
+	for _, forward := range forwards {
+		// Bridge forwards are defined per member and can share the same listen address.
+		if slices.Contains(refs.Forwards[forward.NetworkID], forward.ListenAddress) {
+			continue
+		}
+
+		refs.Forwards[forward.NetworkID] = append(refs.Forwards[forward.NetworkID], forward.ListenAddress)
+	}
+
+	loadBalancers, err := cluster.GetNetworkLoadBalancers(ctx, tx.Tx(), cluster.NetworkLoadBalancerFilter{NetworkID: networkID})
+	if err != nil {
+		return nil, fmt.Errorf("Failed loading network load balancers: %w", err)
+	}
+
+	for _, loadBalancer := range loadBalancers {
+		refs.LoadBalancers[loadBalancer.NetworkID] = append(refs.LoadBalancers[loadBalancer.NetworkID], loadBalancer.ListenAddress)
+	}
+
+	return refs, nil
+}
+
+// URLs returns the URLs of the network ACLs attached to the network as well as of the forwards and load balancers
+// defined on it. Unlike the entries from UsedBy, those don't prevent the network from being deleted.
+func (r *References) URLs(projectName string, networkName string, networkID int64, config map[string]string) []string {
+	var urls []string
+
+	for _, aclName := range util.SplitNTrimSpace(config["security.acls"], ",", -1, true) {
+		urls = append(urls, api.NewURL().Path(version.APIVersion, "network-acls", aclName).Project(projectName).String())
+	}
+
+	for _, listenAddress := range r.Forwards[networkID] {
+		urls = append(urls, api.NewURL().Path(version.APIVersion, "networks", networkName, "forwards", listenAddress).Project(projectName).String())
+	}
+
+	for _, listenAddress := range r.LoadBalancers[networkID] {
+		urls = append(urls, api.NewURL().Path(version.APIVersion, "networks", networkName, "load-balancers", listenAddress).Project(projectName).String())
+	}
+
+	return urls
+}
+
+// ReferencedBy returns the URLs of the network ACLs attached to the network as well as of the forwards and load
+// balancers defined on it. See References.URLs.
+func ReferencedBy(s *state.State, n Network) ([]string, error) {
+	var refs *References
+
+	networkID := n.ID()
+	err := s.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+		var err error
+
+		refs, err = LoadReferences(ctx, tx, &networkID)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return refs.URLs(n.Project(), n.Name(), networkID, n.Config()), nil
+}
+
 // usedByProfileDevices indicates if network is referenced by a profile's NIC devices.
 // Checks if the device's parent or network properties match the network name.
 func usedByProfileDevices(s *state.State, profileDevices map[string]cluster.Device, profileProject *api.Project, networkProjectName string, networkName string, networkType string) (bool, error) {
@@ -1071,6 +1152,59 @@ func parseIPRange(ipRange string, allowedNets ...*net.IPNet) (*iprange.Range, er
 	}, nil
 }
 
+// dhcpReservation represents a static DHCP reservation of a network.
+type dhcpReservation struct {
+	hwaddr   net.HardwareAddr
+	address  net.IP
+	hostname string
+}
+
+// parseDHCPReservations parses a comma separated list of "MAC ADDRESS [HOSTNAME]" DHCP reservations of the given
+// IP family.
+func parseDHCPReservations(value string, family int) ([]dhcpReservation, error) {
+	reservations := []dhcpReservation{}
+	for _, entry := range util.SplitNTrimSpace(value, ",", -1, true) {
+		fields := strings.Fields(entry)
+		if len(fields) < 2 || len(fields) > 3 {
+			return nil, fmt.Errorf("Invalid DHCP reservation %q, expected \"MAC ADDRESS [HOSTNAME]\"", entry)
+		}
+
+		hwaddr, err := net.ParseMAC(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("Invalid MAC address in DHCP reservation %q: %w", entry, err)
+		}
+
+		address := net.ParseIP(fields[1])
+		if address == nil || (family == 4) != (address.To4() != nil) {
+			return nil, fmt.Errorf("Invalid IPv%d address in DHCP reservation %q", family, entry)
+		}
+
+		reservation := dhcpReservation{hwaddr: hwaddr, address: address}
+		if len(fields) == 3 {
+			err = validate.IsHostname(fields[2])
+			if err != nil {
+				return nil, fmt.Errorf("Invalid hostname in DHCP reservation %q: %w", entry, err)
+			}
+
+			reservation.hostname = fields[2]
+		}
+
+		for _, existing := range reservations {
+			if existing.hwaddr.String() == hwaddr.String() {
+				return nil, fmt.Errorf("Duplicate DHCP reservation for MAC address %q", hwaddr.String())
+			}
+
+			if existing.address.Equal(address) {
+				return nil, fmt.Errorf("Duplicate DHCP reservation for address %q", address.String())
+			}
+		}
+
+		reservations = append(reservations, reservation)
+	}
+
+	return reservations, nil
+}
+
 // parseIPRanges parses a comma separated list of IP ranges using parseIPRange.
 func parseIPRanges(ipRangesList string, allowedNets ...*net.IPNet) ([]*iprange.Range, error) {
 	ipRanges := strings.Split(ipRangesList, ",")
@@ -1564,3 +1698,261 @@ func ipInRanges(ipAddr net.IP, ipRanges []iprange.Range) bool {
 
 	return false
 }
+
+// ipv4RangeSize returns the number of addresses in an IPv4 range.
+func ipv4RangeSize(r iprange.Range) uint64 {
+	if r.Start.To4() == nil || r.End.To4() == nil {
+		return 0
+	}
+
+	start := binary.BigEndian.Uint32(r.Start.To4())
+	end := binary.BigEndian.Uint32(r.End.To4())
+	if end < start {
+		return 0
+	}
+
+	return uint64(end-start) + 1
+}
+
+// DHCPv4PoolUsage returns the number of addresses allocated from the network's DHCPv4 pool and the total size of
+// the pool, based on the supplied leases. When no explicit DHCPv4 ranges are configured, the pool is the whole
+// subnet minus the network, gateway and broadcast addresses, matching what dnsmasq is configured with.
+func DHCPv4PoolUsage(n Network, leases []api.NetworkLease) (uint64, uint64, error) {
+	subnet := n.DHCPv4Subnet()
+	if subnet == nil || subnet.IP.To4() == nil {
+		return 0, 0, api.StatusErrorf(http.StatusBadRequest, "Network %q doesn't have DHCPv4 enabled", n.Name())
+	}
+
+	ipRanges := n.DHCPv4Ranges()
+	if len(ipRanges) == 0 {
+		ipRanges = []iprange.Range{{Start: dhcpalloc.GetIP(subnet, 2).To4(), End: dhcpalloc.GetIP(subnet, -2).To4()}}
+	}
+
+	var total uint64
+	for _, r := range ipRanges {
+		total += ipv4RangeSize(r)
+	}
+
+	// Count each address only once as the same address can show up as both static and dynamic.
+	allocated := map[string]struct{}{}
+	for _, lease := range leases {
+		if lease.Type != "static" && lease.Type != "dynamic" {
+			continue
+		}
+
+		ip := net.ParseIP(lease.Address)
+		if ip == nil || ip.To4() == nil || !ipInRanges(ip.To4(), ipRanges) {
+			continue
+		}
+
+		allocated[ip.String()] = struct{}{}
+	}
+
+	return uint64(len(allocated)), total, nil
+}
+
+// ipv6RangeSize returns the number of addresses in an IPv6 range, capped to the largest uint64.
+func ipv6RangeSize(r iprange.Range) uint64 {
+	if r.Start.To16() == nil || r.End.To16() == nil {
+		return 0
+	}
+
+	size := big.NewInt(0).Sub(big.NewInt(0).SetBytes(r.End.To16()), big.NewInt(0).SetBytes(r.Start.To16()))
+	if size.Sign() < 0 {
+		return 0
+	}
+
+	size.Add(size, big.NewInt(1))
+	if !size.IsUint64() {
+		return ^uint64(0)
+	}
+
+	return size.Uint64()
+}
+
+// DHCPv6PoolUsage returns the number of addresses allocated from the network's stateful DHCPv6 pool and the total
+// size of the pool, based on the supplied leases. When no explicit DHCPv6 ranges are configured, the pool is the
+// whole subnet minus the network and gateway addresses, matching what dnsmasq is configured with.
+func DHCPv6PoolUsage(n Network, leases []api.NetworkLease) (uint64, uint64, error) {
+	subnet := n.DHCPv6Subnet()
+	if subnet == nil || subnet.IP.To4() != nil || n.Type() != "bridge" || util.IsFalseOrEmpty(n.Config()["ipv6.dhcp.stateful"]) {
+		return 0, 0, api.StatusErrorf(http.StatusBadRequest, "Network %q doesn't have stateful DHCPv6 enabled", n.Name())
+	}
+
+	ipRanges := n.DHCPv6Ranges()
+	if len(ipRanges) == 0 {
+		ipRanges = []iprange.Range{{Start: dhcpalloc.GetIP(subnet, 2).To16(), End: dhcpalloc.GetIP(subnet, -1).To16()}}
+	}
+
+	var total uint64
+	for _, r := range ipRanges {
+		size := ipv6RangeSize(r)
+		if total+size < total {
+			total = ^uint64(0)
+			break
+		}
+
+		total += size
+	}
+
+	// Count each address only once as the same address can show up as both static and dynamic.
+	allocated := map[string]struct{}{}
+	for _, lease := range leases {
+		if lease.Type != "static" && lease.Type != "dynamic" {
+			continue
+		}
+
+		ip := net.ParseIP(lease.Address)
+		if ip == nil || ip.To4() != nil || !ipInRanges(ip, ipRanges) {
+			continue
+		}
+
+		allocated[ip.String()] = struct{}{}
+	}
+
+	return uint64(len(allocated)), total, nil
+}
+
+// Lint returns a list of advisory messages for common misconfigurations in the supplied network config.
+// Unlike validation, none of these findings prevent the network from being used.
+func Lint(netType string, config map[string]string) []string {
+	messages := []string{}
+
+	if netType != "bridge" && netType != "ovn" {
+		return messages
+	}
+
+	ipv4Address := config["ipv4.address"]
+	ipv6Address := config["ipv6.address"]
+
+	if ipv4Address == "none" && ipv6Address == "none" {
+		messages = append(messages, "Network has neither IPv4 nor IPv6 addressing configured")
+	}
+
+	if !util.IsNoneOrEmpty(ipv4Address) && util.IsTrueOrEmpty(config["ipv4.dhcp"]) && config["dns.mode"] == "none" {
+		messages = append(messages, `DHCPv4 is enabled but DNS is disabled ("dns.mode" is "none")`)
+	}
+
+	_, ipv4Net, _ := net.ParseCIDR(ipv4Address)
+	if ipv4Net != nil && config["ipv4.dhcp.ranges"] != "" {
+		ones, bits := ipv4Net.Mask.Size()
+		subnetSize := uint64(1) << (bits - ones)
+
+		ipRanges, err := parseIPRanges(config["ipv4.dhcp.ranges"], ipv4Net)
+		if err == nil {
+			var rangeSize uint64
+			for _, r := range ipRanges {
+				rangeSize += ipv4RangeSize(*r)
+			}
+
+			if ones <= 20 && rangeSize*16 < subnetSize {
+				messages = append(messages, fmt.Sprintf("Large IPv4 subnet (/%d) with a small DHCPv4 range (%d addresses)", ones, rangeSize))
+			}
+		}
+	}
+
+	_, ipv6Net, _ := net.ParseCIDR(ipv6Address)
+	if ipv6Net != nil && util.IsFalseOrEmpty(config["ipv6.dhcp.stateful"]) {
+		ones, _ := ipv6Net.Mask.Size()
+		if ones != 64 {
+			messages = append(messages, fmt.Sprintf("IPv6 subnet is a /%d but SLAAC requires a /64 (consider enabling \"ipv6.dhcp.stateful\")", ones))
+		}
+	}
+
+	return messages
+}
+
+// GatewayAddresses returns the upstream gateway addresses the network's traffic leaves through.
+// For bridge networks these are the host's default gateways for the families the bridge has addresses for,
+// for OVN networks it's the gateway of the uplink network.
+func GatewayAddresses(s *state.State, n Network) ([]net.IP, error) {
+	netConfig := n.Config()
+
+	var keys []string
+	switch n.Type() {
+	case "bridge":
+		gateways := []net.IP{}
+		for _, family := range []ip.Family{ip.FamilyV4, ip.FamilyV6} {
+			key := "ipv4.address"
+			if family == ip.FamilyV6 {
+				key = "ipv6.address"
+			}
+
+			if util.IsNoneOrEmpty(netConfig[key]) {
+				continue
+			}
+
+			familyGateways, err := ip.DefaultGateways(family)
+			if err != nil {
+				return nil, err
+			}
+
+			gateways = append(gateways, familyGateways...)
+		}
+
+		return gateways, nil
+	case "ovn":
+		if util.IsNoneOrEmpty(netConfig["network"]) {
+			return nil, nil
+		}
+
+		uplink, err := LoadByName(s, api.ProjectDefaultName, netConfig["network"])
+		if err != nil {
+			return nil, fmt.Errorf("Failed loading uplink network %q: %w", netConfig["network"], err)
+		}
+
+		return GatewayAddresses(s, uplink)
+	default:
+		keys = []string{"ipv4.gateway", "ipv6.gateway"}
+	}
+
+	gateways := []net.IP{}
+	for _, key := range keys {
+		ip, _, err := net.ParseCIDR(netConfig[key])
+		if err != nil {
+			ip = net.ParseIP(netConfig[key])
+		}
+
+		if ip != nil {
+			gateways = append(gateways, ip)
+		}
+	}
+
+	return gateways, nil
+}
+
+// ProbeGateways checks whether each of the network's gateways is reachable from the local member.
+func ProbeGateways(s *state.State, n Network) (*api.NetworkConnectivity, error) {
+	gateways, err := GatewayAddresses(s, n)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &api.NetworkConnectivity{
+		Location: s.ServerName,
+		Gateways: make([]api.NetworkConnectivityGateway, 0, len(gateways)),
+	}
+
+	for _, gateway := range gateways {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		err := pingIP(ctx, gateway)
+		cancel()
+
+		result.Gateways = append(result.Gateways, api.NetworkConnectivityGateway{
+			Address:   gateway.String(),
+			Reachable: err == nil,
+		})
+	}
+
+	return result, nil
+}
+
+// instanceCounters converts the counters of the host side interface of an instance NIC to the instance's point of view.
+func instanceCounters(hostCounters api.NetworkStateCounters) api.NetworkStateCounters {
+	return api.NetworkStateCounters{
+		BytesReceived:   hostCounters.BytesSent,
+		BytesSent:       hostCounters.BytesReceived,
+		PacketsReceived: hostCounters.PacketsSent,
+		PacketsSent:     hostCounters.PacketsReceived,
+	}
+}
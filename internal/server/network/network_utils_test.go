@@ -1,11 +1,14 @@
 package network
 
 import (
+	"errors"
 	"fmt"
 	"net"
 	"strings"
 
 	"github.com/lxc/incus/v6/internal/iprange"
+	"github.com/lxc/incus/v6/shared/api"
+	"github.com/lxc/incus/v6/shared/validate"
 )
 
 func Example_parseIPRange() {
@@ -180,3 +183,225 @@ func Example_complementRanges() {
 	// Range2: 10.1.1.1-10.1.1.9, 10.1.1.101-10.1.1.199, 10.1.1.231-10.1.1.254
 	// Range3: 10.1.1.1-10.1.1.9, 10.1.1.26-10.1.1.254
 }
+
+func ExampleReferences_URLs() {
+	refs := &References{
+		Forwards:      map[int64][]string{1: {"192.0.2.1"}},
+		LoadBalancers: map[int64][]string{1: {"192.0.2.2"}, 2: {"192.0.2.3"}},
+	}
+
+	config := map[string]string{"security.acls": "web, ssh"}
+
+	for _, url := range refs.URLs("project1", "ovn0", 1, config) {
+		fmt.Println(url)
+	}
+
+	fmt.Println(len(refs.URLs("project1", "ovn1", 3, nil)))
+
+	// Output: /1.0/network-acls/web?project=project1
+	// /1.0/network-acls/ssh?project=project1
+	// /1.0/networks/ovn0/forwards/192.0.2.1?project=project1
+	// /1.0/networks/ovn0/load-balancers/192.0.2.2?project=project1
+	// 0
+}
+
+func Example_parseDHCPReservations() {
+	tests := []struct {
+		value  string
+		family int
+	}{
+		{"00:16:3e:00:00:01 10.0.0.10 printer, 00:16:3e:00:00:02 10.0.0.11", 4},
+		{"00:16:3e:00:00:01 fd42::10", 6},
+		{"00:16:3e:00:00:01 fd42::10", 4},
+		{"00:16:3e:00:00:01", 4},
+		{"not-a-mac 10.0.0.10", 4},
+		{"00:16:3e:00:00:01 10.0.0.10 not_a_hostname!", 4},
+		{"00:16:3e:00:00:01 10.0.0.10, 00:16:3e:00:00:01 10.0.0.11", 4},
+		{"00:16:3e:00:00:01 10.0.0.10, 00:16:3e:00:00:02 10.0.0.10", 4},
+	}
+
+	for _, t := range tests {
+		reservations, err := parseDHCPReservations(t.value, t.family)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+
+		for _, reservation := range reservations {
+			fmt.Printf("%s %s %q\n", reservation.hwaddr, reservation.address, reservation.hostname)
+		}
+	}
+
+	// Output: 00:16:3e:00:00:01 10.0.0.10 "printer"
+	// 00:16:3e:00:00:02 10.0.0.11 ""
+	// 00:16:3e:00:00:01 fd42::10 ""
+	// Invalid IPv4 address in DHCP reservation "00:16:3e:00:00:01 fd42::10"
+	// Invalid DHCP reservation "00:16:3e:00:00:01", expected "MAC ADDRESS [HOSTNAME]"
+	// Invalid MAC address in DHCP reservation "not-a-mac 10.0.0.10": address not-a-mac: invalid MAC address
+	// Invalid hostname in DHCP reservation "00:16:3e:00:00:01 10.0.0.10 not_a_hostname!": Name can only contain alphanumeric and hyphen characters
+	// Duplicate DHCP reservation for MAC address "00:16:3e:00:00:01"
+	// Duplicate DHCP reservation for address "10.0.0.10"
+}
+
+func ExampleLint() {
+	tests := []struct {
+		netType string
+		config  map[string]string
+	}{
+		{"macvlan", map[string]string{"ipv4.address": "none", "ipv6.address": "none"}},
+		{"bridge", map[string]string{"ipv4.address": "10.0.0.1/24", "ipv6.address": "fd42::1/64"}},
+		{"bridge", map[string]string{"ipv4.address": "none", "ipv6.address": "none"}},
+		{"ovn", map[string]string{"ipv4.address": "10.0.0.1/24", "ipv6.address": "none", "dns.mode": "none"}},
+		{"bridge", map[string]string{"ipv4.address": "10.0.0.1/24", "ipv4.dhcp": "false", "ipv6.address": "none", "dns.mode": "none"}},
+		{"bridge", map[string]string{"ipv4.address": "10.0.0.1/16", "ipv4.dhcp.ranges": "10.0.0.10-10.0.0.20", "ipv6.address": "none"}},
+		{"bridge", map[string]string{"ipv4.address": "10.0.0.1/24", "ipv4.dhcp.ranges": "10.0.0.10-10.0.0.20", "ipv6.address": "none"}},
+		{"bridge", map[string]string{"ipv4.address": "none", "ipv6.address": "fd42::1/56"}},
+		{"bridge", map[string]string{"ipv4.address": "none", "ipv6.address": "fd42::1/56", "ipv6.dhcp.stateful": "true"}},
+	}
+
+	for _, t := range tests {
+		fmt.Printf("%s: %q\n", t.netType, Lint(t.netType, t.config))
+	}
+
+	// Output: macvlan: []
+	// bridge: []
+	// bridge: ["Network has neither IPv4 nor IPv6 addressing configured"]
+	// ovn: ["DHCPv4 is enabled but DNS is disabled (\"dns.mode\" is \"none\")"]
+	// bridge: []
+	// bridge: ["Large IPv4 subnet (/16) with a small DHCPv4 range (11 addresses)"]
+	// bridge: []
+	// bridge: ["IPv6 subnet is a /56 but SLAAC requires a /64 (consider enabling \"ipv6.dhcp.stateful\")"]
+	// bridge: []
+}
+
+func ExampleDHCPv4PoolUsage() {
+	leases := []api.NetworkLease{
+		{Address: "10.0.0.1", Type: "gateway"},
+		{Address: "10.0.0.10", Type: "static"},
+		{Address: "10.0.0.10", Type: "dynamic"},
+		{Address: "10.0.0.11", Type: "dynamic"},
+		{Address: "10.0.0.50", Type: "dynamic"},
+		{Address: "fd42::10", Type: "dynamic"},
+	}
+
+	configs := []map[string]string{
+		{"ipv4.address": "10.0.0.1/24"},
+		{"ipv4.address": "10.0.0.1/24", "ipv4.dhcp.ranges": "10.0.0.10-10.0.0.19, 10.0.0.100-10.0.0.109"},
+		{"ipv4.address": "10.0.0.1/24", "ipv4.dhcp": "false"},
+		{"ipv4.address": "none"},
+	}
+
+	for _, config := range configs {
+		n := &bridge{common: common{name: "br0", netType: "bridge", config: config}}
+
+		allocated, total, err := DHCPv4PoolUsage(n, leases)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+
+		fmt.Printf("%d/%d\n", allocated, total)
+	}
+
+	// Output: 3/253
+	// 2/20
+	// Network "br0" doesn't have DHCPv4 enabled
+	// Network "br0" doesn't have DHCPv4 enabled
+}
+
+func Example_ipv6RangeSize() {
+	ranges := [][2]string{
+		{"fd42::10", "fd42::1f"},
+		{"fd42::1", "fd42::1"},
+		{"fd42::1f", "fd42::10"},
+		{"fd42::", "fd42:0:0:1::"},
+		{"fd42::", "fd43::"},
+	}
+
+	for _, r := range ranges {
+		fmt.Println(ipv6RangeSize(iprange.Range{Start: net.ParseIP(r[0]), End: net.ParseIP(r[1])}))
+	}
+
+	// Output: 16
+	// 1
+	// 0
+	// 18446744073709551615
+	// 18446744073709551615
+}
+
+func ExampleDHCPv6PoolUsage() {
+	leases := []api.NetworkLease{
+		{Address: "fd42::1", Type: "gateway"},
+		{Address: "fd42::10", Type: "static"},
+		{Address: "fd42::10", Type: "dynamic"},
+		{Address: "fd42::100", Type: "dynamic"},
+		{Address: "fd42::200", Type: "dynamic"},
+		{Address: "10.0.0.10", Type: "dynamic"},
+	}
+
+	configs := []map[string]string{
+		{"ipv6.address": "fd42::1/64", "ipv6.dhcp.stateful": "true"},
+		{"ipv6.address": "fd42::1/64", "ipv6.dhcp.stateful": "true", "ipv6.dhcp.ranges": "fd42::10-fd42::1f, fd42::100-fd42::10f"},
+		{"ipv6.address": "fd42::1/120", "ipv6.dhcp.stateful": "true"},
+		{"ipv6.address": "fd42::1/64"},
+		{"ipv6.address": "fd42::1/64", "ipv6.dhcp": "false", "ipv6.dhcp.stateful": "true"},
+	}
+
+	for _, config := range configs {
+		n := &bridge{common: common{name: "br0", netType: "bridge", config: config}}
+
+		allocated, total, err := DHCPv6PoolUsage(n, leases)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+
+		fmt.Printf("%d/%d\n", allocated, total)
+	}
+
+	// Output: 3/18446744073709551614
+	// 2/32
+	// 1/254
+	// Network "br0" doesn't have stateful DHCPv6 enabled
+	// Network "br0" doesn't have stateful DHCPv6 enabled
+}
+
+func Example_commonValidate() {
+	n := &common{name: "br0"}
+	rules := map[string]func(string) error{
+		"bridge.mtu": validate.Optional(validate.IsNetworkMTU),
+		"ipv4.nat":   validate.Optional(validate.IsBool),
+	}
+
+	configs := []map[string]string{
+		{"bridge.mtu": "1500", "ipv4.nat": "true", "user.foo": "bar"},
+		{"ipv4.nat": "maybe"},
+		{"unknown.key": "1", "ipv4.nat": "maybe", "bridge.mtu": "huge", "startup.priority": "first", "user.foo": "bar"},
+	}
+
+	for _, config := range configs {
+		err := n.validate(config, rules)
+		if err == nil {
+			fmt.Println("Valid")
+			continue
+		}
+
+		fmt.Printf("Error: %v\n", err)
+
+		var keyErrs ConfigKeyErrors
+		if errors.As(err, &keyErrs) {
+			for _, keyErr := range keyErrs {
+				fmt.Printf("- %s: %v\n", keyErr.Key, keyErr)
+			}
+		}
+	}
+
+	// Output: Valid
+	// Error: Invalid value for network "br0" option "ipv4.nat": Invalid value for a boolean "maybe"
+	// - ipv4.nat: Invalid value for network "br0" option "ipv4.nat": Invalid value for a boolean "maybe"
+	// Error: Invalid value for network "br0" option "bridge.mtu": Invalid MTU "huge"
+	// - bridge.mtu: Invalid value for network "br0" option "bridge.mtu": Invalid MTU "huge"
+	// - ipv4.nat: Invalid value for network "br0" option "ipv4.nat": Invalid value for a boolean "maybe"
+	// - startup.priority: Invalid value for network "br0" option "startup.priority": Invalid value for an integer "first"
+	// - unknown.key: Invalid option for network "br0" option "unknown.key"
+}
@@ -7,6 +7,7 @@ import (
 	"maps"
 	"net"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -123,6 +124,24 @@ type OVNDHCPv6Opts struct {
 	DHCPv6Stateless    bool
 }
 
+// OVNSwitchPortBinding represents the addresses bound to a switch port and its port security settings.
+type OVNSwitchPortBinding struct {
+	Name         OVNSwitchPort
+	MAC          net.HardwareAddr
+	IPs          []net.IP
+	PortSecurity []string
+	Up           bool
+	Location     string
+}
+
+// OVNSwitchPortQoS represents the rate limits in bits per second applied to a switch port, zero meaning no limit.
+// The ingress limit applies to the traffic sent to the port and the egress limit to the traffic it sends.
+type OVNSwitchPortQoS struct {
+	Name    OVNSwitchPort
+	Ingress uint64
+	Egress  uint64
+}
+
 // OVNSwitchPortOpts options that can be applied to a switch port.
 type OVNSwitchPortOpts struct {
 	MAC          net.HardwareAddr   // Optional, if nil will be set to dynamic.
@@ -806,6 +825,30 @@ func (o *NB) GetLogicalRouterPort(ctx context.Context, portName OVNRouterPort) (
 	return logicalRouterPort, nil
 }
 
+// GetLogicalRouterPortRecords gets the OVN database records for the ports of the logical router.
+func (o *NB) GetLogicalRouterPortRecords(ctx context.Context, routerName OVNRouter) ([]ovnNB.LogicalRouterPort, error) {
+	logicalRouter, err := o.GetLogicalRouter(ctx, routerName)
+	if err != nil {
+		return nil, err
+	}
+
+	ports := make([]ovnNB.LogicalRouterPort, 0, len(logicalRouter.Ports))
+	for _, portUUID := range logicalRouter.Ports {
+		lrp := ovnNB.LogicalRouterPort{
+			UUID: portUUID,
+		}
+
+		err := o.get(ctx, &lrp)
+		if err != nil {
+			return nil, err
+		}
+
+		ports = append(ports, lrp)
+	}
+
+	return ports, nil
+}
+
 // CreateLogicalRouterPort adds a named logical router port to a logical router.
 func (o *NB) CreateLogicalRouterPort(ctx context.Context, routerName OVNRouter, portName OVNRouterPort, mac net.HardwareAddr, gatewayMTU uint32, ipAddr []*net.IPNet, haChassisGroupName OVNChassisGroup, mayExist bool) error {
 	// Prepare the addresses.
@@ -1701,6 +1744,104 @@ func (o *NB) GetLogicalSwitchPorts(ctx context.Context, switchName OVNSwitch) (m
 	return ports, nil
 }
 
+// GetLogicalSwitchPortRecords gets the OVN database records for the ports connected to the logical switch.
+func (o *NB) GetLogicalSwitchPortRecords(ctx context.Context, switchName OVNSwitch) ([]ovnNB.LogicalSwitchPort, error) {
+	logicalSwitch, err := o.GetLogicalSwitch(ctx, switchName)
+	if err != nil {
+		return nil, err
+	}
+
+	ports := make([]ovnNB.LogicalSwitchPort, 0, len(logicalSwitch.Ports))
+	for _, portUUID := range logicalSwitch.Ports {
+		lsp := ovnNB.LogicalSwitchPort{
+			UUID: portUUID,
+		}
+
+		err := o.get(ctx, &lsp)
+		if err != nil {
+			return nil, err
+		}
+
+		ports = append(ports, lsp)
+	}
+
+	return ports, nil
+}
+
+// GetLogicalSwitchPortQoS returns the rate limits applied to the ports of the switch, either through the port's
+// qos_max_rate option or through the switch's QoS rules matching a single port. Ports without limits are skipped.
+func (o *NB) GetLogicalSwitchPortQoS(ctx context.Context, switchName OVNSwitch) ([]OVNSwitchPortQoS, error) {
+	logicalSwitch, err := o.GetLogicalSwitch(ctx, switchName)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := map[OVNSwitchPort]*OVNSwitchPortQoS{}
+	getLimit := func(portName OVNSwitchPort) *OVNSwitchPortQoS {
+		limit, ok := limits[portName]
+		if !ok {
+			limit = &OVNSwitchPortQoS{Name: portName}
+			limits[portName] = limit
+		}
+
+		return limit
+	}
+
+	lsps, err := o.GetLogicalSwitchPortRecords(ctx, switchName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lsp := range lsps {
+		rate, err := strconv.ParseUint(lsp.Options["qos_max_rate"], 10, 64)
+		if err == nil && rate > 0 {
+			getLimit(OVNSwitchPort(lsp.Name)).Egress = rate
+		}
+	}
+
+	for _, qosUUID := range logicalSwitch.QOSRules {
+		qos := ovnNB.QoS{
+			UUID: qosUUID,
+		}
+
+		err := o.get(ctx, &qos)
+		if err != nil {
+			return nil, err
+		}
+
+		// QoS rates are expressed in kbps.
+		rate := uint64(qos.Bandwidth[ovnNB.QoSBandwidthRate]) * 1000
+		if rate == 0 {
+			continue
+		}
+
+		// Only consider the rules matching a single port.
+		field := "inport"
+		if qos.Direction == ovnNB.QoSDirectionToLport {
+			field = "outport"
+		}
+
+		portName, ok := strings.CutPrefix(strings.TrimSpace(qos.Match), field+" == ")
+		if !ok || strings.ContainsAny(portName, " &|") {
+			continue
+		}
+
+		limit := getLimit(OVNSwitchPort(strings.Trim(portName, `"`)))
+		if qos.Direction == ovnNB.QoSDirectionToLport {
+			limit.Ingress = rate
+		} else {
+			limit.Egress = rate
+		}
+	}
+
+	result := make([]OVNSwitchPortQoS, 0, len(limits))
+	for _, portName := range slices.Sorted(maps.Keys(limits)) {
+		result = append(result, *limits[portName])
+	}
+
+	return result, nil
+}
+
 // GetLogicalSwitchIPs returns a list of IPs associated to each port connected to switch.
 func (o *NB) GetLogicalSwitchIPs(ctx context.Context, switchName OVNSwitch) (map[OVNSwitchPort][]net.IP, error) {
 	lsps := []ovnNB.LogicalSwitchPort{}
@@ -1742,6 +1883,54 @@ func (o *NB) GetLogicalSwitchIPs(ctx context.Context, switchName OVNSwitch) (map
 	return portIPs, nil
 }
 
+// GetLogicalSwitchPortBindings returns the MAC and IP addresses bound to each regular port connected to switch.
+func (o *NB) GetLogicalSwitchPortBindings(ctx context.Context, switchName OVNSwitch) ([]OVNSwitchPortBinding, error) {
+	lsps := []ovnNB.LogicalSwitchPort{}
+
+	err := o.client.WhereCache(func(lsp *ovnNB.LogicalSwitchPort) bool {
+		return lsp.ExternalIDs != nil && lsp.ExternalIDs[ovnExtIDIncusSwitch] == string(switchName) && lsp.Type == ""
+	}).List(ctx, &lsps)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings := make([]OVNSwitchPortBinding, 0, len(lsps))
+	for _, lsp := range lsps {
+		binding := OVNSwitchPortBinding{
+			Name:         OVNSwitchPort(lsp.Name),
+			PortSecurity: lsp.PortSecurity,
+			Up:           lsp.Up != nil && *lsp.Up,
+			Location:     lsp.ExternalIDs[ovnExtIDIncusLocation],
+		}
+
+		entries := []string{}
+		for _, address := range lsp.Addresses {
+			entries = append(entries, util.SplitNTrimSpace(address, " ", -1, true)...)
+		}
+
+		if lsp.DynamicAddresses != nil {
+			entries = append(entries, util.SplitNTrimSpace(*lsp.DynamicAddresses, " ", -1, true)...)
+		}
+
+		for _, entry := range entries {
+			ip := net.ParseIP(entry)
+			if ip != nil {
+				binding.IPs = append(binding.IPs, ip)
+				continue
+			}
+
+			mac, err := net.ParseMAC(entry)
+			if err == nil && binding.MAC == nil {
+				binding.MAC = mac
+			}
+		}
+
+		bindings = append(bindings, binding)
+	}
+
+	return bindings, nil
+}
+
 // GetLogicalSwitchPortUUID returns the logical switch port UUID.
 func (o *NB) GetLogicalSwitchPortUUID(ctx context.Context, portName OVNSwitchPort) (OVNSwitchPortUUID, error) {
 	// Get the logical switch port.
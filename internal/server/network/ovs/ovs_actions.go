@@ -494,6 +494,21 @@ func (o *VSwitch) GetInterfaceAssociatedOVNSwitchPort(ctx context.Context, inter
 	return ovsInterface.ExternalIDs["iface-id"], nil
 }
 
+// GetInterfaceStatistics returns the statistics (such as rx_bytes or tx_packets) of the interface.
+func (o *VSwitch) GetInterfaceStatistics(ctx context.Context, interfaceName string) (map[string]int, error) {
+	// Get the OVS interface.
+	ovsInterface := ovsSwitch.Interface{
+		Name: interfaceName,
+	}
+
+	err := o.client.Get(ctx, &ovsInterface)
+	if err != nil {
+		return nil, err
+	}
+
+	return ovsInterface.Statistics, nil
+}
+
 // GetChassisID returns the local chassis ID.
 func (o *VSwitch) GetChassisID(ctx context.Context) (string, error) {
 	// Get the root switch.
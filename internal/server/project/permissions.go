@@ -1552,8 +1552,10 @@ func FilterUsedBy(authorizer auth.Authorizer, r *http.Request, entries []string)
 			object = auth.ObjectImage(projectName, pathArgs[0])
 		case cluster.TypeInstance:
 			object = auth.ObjectInstance(projectName, pathArgs[0])
-		case cluster.TypeNetwork:
+		case cluster.TypeNetwork, cluster.TypeNetworkForward, cluster.TypeNetworkLoadBalancer:
 			object = auth.ObjectNetwork(projectName, pathArgs[0])
+		case cluster.TypeNetworkACL:
+			object = auth.ObjectNetworkACL(projectName, pathArgs[0])
 		case cluster.TypeProfile:
 			object = auth.ObjectProfile(projectName, pathArgs[0])
 		case cluster.TypeStoragePool:
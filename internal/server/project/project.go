@@ -231,6 +231,49 @@ func NetworkAllowed(reqProjectConfig map[string]string, networkName string, isMa
 	return slices.Contains(allowedRestrictedNetworks, networkName)
 }
 
+// LoadProjectsWithConfig returns all the projects along with their configuration.
+func LoadProjectsWithConfig(ctx context.Context, tx *db.ClusterTx) ([]api.Project, error) {
+	dbProjects, err := cluster.GetProjects(ctx, tx.Tx())
+	if err != nil {
+		return nil, fmt.Errorf("Failed loading projects: %w", err)
+	}
+
+	projectsConfig, err := cluster.GetConfig(ctx, tx.Tx(), "projects", "project")
+	if err != nil {
+		return nil, fmt.Errorf("Failed loading projects config: %w", err)
+	}
+
+	projects := make([]api.Project, 0, len(dbProjects))
+	for _, dbProject := range dbProjects {
+		projects = append(projects, api.Project{Name: dbProject.Name, ProjectPut: api.ProjectPut{Config: projectsConfig[dbProject.ID]}})
+	}
+
+	return projects, nil
+}
+
+// NetworkAllowedProjects returns the names of the projects allowed to use the network in the given project.
+// A network is usable from the projects whose networks live in its project (see NetworkProjectFromRecord) and
+// whose restrictions allow access to it (see NetworkAllowed).
+// The projects are those returned by LoadProjectsWithConfig.
+func NetworkAllowedProjects(projects []api.Project, networkProjectName string, networkName string, isManaged bool) []string {
+	allowed := []string{}
+	for _, p := range projects {
+		if NetworkProjectFromRecord(&p) != networkProjectName {
+			continue
+		}
+
+		if !NetworkAllowed(p.Config, networkName, isManaged) {
+			continue
+		}
+
+		allowed = append(allowed, p.Name)
+	}
+
+	slices.Sort(allowed)
+
+	return allowed
+}
+
 // NetworkIntegrationAllowed returns whether access is allowed for a particular network integration based on projectConfig.
 func NetworkIntegrationAllowed(reqProjectConfig map[string]string, integrationName string) bool {
 	// If project is not restricted, then access to network is allowed.
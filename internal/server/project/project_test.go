@@ -45,3 +45,19 @@ func ExampleStorageVolume() {
 	// Output: default_test
 	// project_name_test1
 }
+
+func ExampleNetworkAllowedProjects() {
+	projects := []api.Project{
+		{Name: api.ProjectDefaultName},
+		{Name: "shared", ProjectPut: api.ProjectPut{Config: map[string]string{"features.networks": "false"}}},
+		{Name: "isolated", ProjectPut: api.ProjectPut{Config: map[string]string{"features.networks": "true"}}},
+		{Name: "restricted", ProjectPut: api.ProjectPut{Config: map[string]string{"restricted": "true", "restricted.networks.access": "other"}}},
+		{Name: "allowed", ProjectPut: api.ProjectPut{Config: map[string]string{"restricted": "true", "restricted.networks.access": "incusbr0"}}},
+	}
+
+	fmt.Println(project.NetworkAllowedProjects(projects, api.ProjectDefaultName, "incusbr0", true))
+	fmt.Println(project.NetworkAllowedProjects(projects, "isolated", "ovn0", true))
+
+	// Output: [allowed default shared]
+	// [isolated]
+}
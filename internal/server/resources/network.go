@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"io/fs"
@@ -593,9 +594,18 @@ func GetNetworkState(name string) (*api.NetworkState, error) {
 		}
 
 		// Lower devices.
+		bonding.Members = []api.NetworkStateMember{}
 		strValue, err = os.ReadFile(filepath.Join(bondPath, "slaves"))
 		if err == nil {
 			bonding.LowerDevices = strings.Split(strings.TrimSpace(string(strValue)), " ")
+
+			for _, device := range bonding.LowerDevices {
+				if device == "" {
+					continue
+				}
+
+				bonding.Members = append(bonding.Members, getNetworkMemberState(device))
+			}
 		}
 
 		network.Bond = &bonding
@@ -648,6 +658,11 @@ func GetNetworkState(name string) (*api.NetworkState, error) {
 			}
 		}
 
+		bridge.Members = make([]api.NetworkStateMember, 0, len(bridge.UpperDevices))
+		for _, device := range bridge.UpperDevices {
+			bridge.Members = append(bridge.Members, getNetworkMemberState(device))
+		}
+
 		network.Bridge = &bridge
 	}
 
@@ -707,6 +722,43 @@ func GetNetworkState(name string) (*api.NetworkState, error) {
 	return &network, nil
 }
 
+// getNetworkMemberState returns the link state, speed and counters of a bond or bridge member device.
+// Information which can't be retrieved is left empty.
+func getNetworkMemberState(name string) api.NetworkStateMember {
+	member := api.NetworkStateMember{
+		Name:  name,
+		State: "down",
+	}
+
+	devicePath := fmt.Sprintf("/sys/class/net/%s", name)
+
+	// Link state.
+	strValue, err := os.ReadFile(filepath.Join(devicePath, "operstate"))
+	if err == nil && strings.TrimSpace(string(strValue)) == "up" {
+		member.State = "up"
+	}
+
+	// Link speed (unavailable when the link is down).
+	uintValue, err := readUint(filepath.Join(devicePath, "speed"))
+	if err == nil {
+		member.Speed = uintValue
+	}
+
+	// Bond member link state.
+	strValue, err = os.ReadFile(filepath.Join(devicePath, "bonding_slave", "mii_status"))
+	if err == nil {
+		member.MIIState = strings.TrimSpace(string(strValue))
+	}
+
+	// Counters.
+	counters, err := GetNetworkCounters(name)
+	if err == nil {
+		member.Counters = counters
+	}
+
+	return member
+}
+
 // GetNetworkCounters returns the current packet counters for the network interface.
 func GetNetworkCounters(name string) (*api.NetworkStateCounters, error) {
 	counters := api.NetworkStateCounters{}
@@ -762,3 +814,79 @@ func GetNetworkCounters(name string) (*api.NetworkStateCounters, error) {
 
 	return &counters, nil
 }
+
+// GetNetworkQueues returns the per-queue information exposed in sysfs for the network interface.
+// The kernel doesn't expose per-queue packet counters in sysfs, transmit queues instead report their
+// byte queue limits which is what shows a queue being saturated.
+func GetNetworkQueues(name string) ([]api.NetworkStateQueue, error) {
+	queues := []api.NetworkStateQueue{}
+
+	queuesPath := filepath.Join(sysClassNet, name, "queues")
+	entries, err := os.ReadDir(queuesPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return queues, nil
+		}
+
+		return nil, fmt.Errorf("Failed to list %q: %w", queuesPath, err)
+	}
+
+	for _, entry := range entries {
+		direction, indexStr, ok := strings.Cut(entry.Name(), "-")
+		if !ok || (direction != "rx" && direction != "tx") {
+			continue
+		}
+
+		index, err := strconv.ParseUint(indexStr, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		queuePath := filepath.Join(queuesPath, entry.Name())
+		queue := api.NetworkStateQueue{
+			Name:      entry.Name(),
+			Direction: direction,
+			Index:     index,
+		}
+
+		cpusFile := "rps_cpus"
+		if direction == "tx" {
+			cpusFile = "xps_cpus"
+		}
+
+		content, err := os.ReadFile(filepath.Join(queuePath, cpusFile))
+		if err == nil {
+			queue.CPUs = strings.TrimSpace(string(content))
+		}
+
+		if direction == "rx" {
+			flows, err := readUint(filepath.Join(queuePath, "rps_flow_cnt"))
+			if err == nil {
+				queue.Flows = flows
+			}
+		} else {
+			maxRate, err := readUint(filepath.Join(queuePath, "tx_maxrate"))
+			if err == nil {
+				queue.MaxRate = maxRate
+			}
+
+			inflight, err := readUint(filepath.Join(queuePath, "byte_queue_limits", "inflight"))
+			if err == nil {
+				queue.BytesInflight = inflight
+			}
+
+			limit, err := readUint(filepath.Join(queuePath, "byte_queue_limits", "limit"))
+			if err == nil {
+				queue.BytesLimit = limit
+			}
+		}
+
+		queues = append(queues, queue)
+	}
+
+	slices.SortFunc(queues, func(a api.NetworkStateQueue, b api.NetworkStateQueue) int {
+		return cmp.Or(strings.Compare(a.Direction, b.Direction), cmp.Compare(a.Index, b.Index))
+	})
+
+	return queues, nil
+}
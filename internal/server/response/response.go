@@ -257,6 +257,36 @@ func (r *syncResponse) Code() int {
 	return r.code
 }
 
+// Not modified response.
+type notModifiedResponse struct {
+	etag any
+}
+
+// NotModified returns a not modified response (304) for the given etag.
+func NotModified(etag any) Response {
+	return &notModifiedResponse{etag: etag}
+}
+
+func (r *notModifiedResponse) Render(w http.ResponseWriter) error {
+	etag, err := localUtil.EtagHash(r.etag)
+	if err == nil {
+		w.Header().Set("ETag", fmt.Sprintf("\"%s\"", etag))
+	}
+
+	w.WriteHeader(http.StatusNotModified)
+
+	return nil
+}
+
+func (r *notModifiedResponse) String() string {
+	return "not modified"
+}
+
+// Code returns the HTTP code.
+func (r *notModifiedResponse) Code() int {
+	return http.StatusNotModified
+}
+
 // Error response.
 type errorResponse struct {
 	code int    // Code to return in both the HTTP header and Code field of the response body.
@@ -137,3 +137,21 @@ type swaggerNotFound struct {
 		ErrorCode int `json:"error_code"`
 	}
 }
+
+// Conflict
+//
+// swagger:response Conflict
+type swaggerConflict struct {
+	// Conflict
+	// in: body
+	Body struct {
+		// Example: error
+		Type string `json:"type"`
+
+		// Example: conflict
+		Error string `json:"error"`
+
+		// Example: 409
+		ErrorCode int `json:"error_code"`
+	}
+}
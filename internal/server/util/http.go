@@ -98,6 +98,29 @@ func EtagCheck(r *http.Request, data any) error {
 	return nil
 }
 
+// EtagNoneMatch returns whether the hash of the current state matches the hash
+// provided by the client in the If-None-Match header, meaning it's up to date.
+func EtagNoneMatch(r *http.Request, data any) (bool, error) {
+	noneMatch := r.Header.Get("If-None-Match")
+	if noneMatch == "" {
+		return false, nil
+	}
+
+	hash, err := EtagHash(data)
+	if err != nil {
+		return false, err
+	}
+
+	for _, match := range strings.Split(noneMatch, ",") {
+		match = strings.TrimPrefix(strings.TrimSpace(match), "W/")
+		if match == "*" || strings.Trim(match, "\"") == hash {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 // HTTPClient returns an http.Client using the given certificate and proxy.
 func HTTPClient(certificate string, proxy proxyFunc) (*http.Client, error) {
 	var err error
@@ -2,6 +2,7 @@ package util
 
 import (
 	"fmt"
+	"net/http"
 )
 
 func ExampleListenAddresses() {
@@ -38,3 +39,24 @@ func ExampleListenAddresses() {
 	// "foo:8000:9000": [] address foo:8000:9000: too many colons in address
 	// ":::8000": [] address :::8000: too many colons in address
 }
+
+func ExampleEtagNoneMatch() {
+	state := map[string]string{"state": "up"}
+	hash, _ := EtagHash(state)
+
+	for _, header := range []string{"", fmt.Sprintf("%q", hash), fmt.Sprintf("W/%q, \"other\"", hash), "\"other\"", "*"} {
+		r := &http.Request{Header: http.Header{}}
+		if header != "" {
+			r.Header.Set("If-None-Match", header)
+		}
+
+		match, err := EtagNoneMatch(r, state)
+		fmt.Printf("%v %v\n", match, err)
+	}
+
+	// Output: false <nil>
+	// true <nil>
+	// true <nil>
+	// false <nil>
+	// true <nil>
+}
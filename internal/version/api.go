@@ -489,6 +489,93 @@ var APIExtensions = []string{
 	"backup_s3_upload",
 	"snapshot_manual_expiry",
 	"resources_cpu_address_sizes",
+	"network_state_dhcp_utilization",
+	"network_create_lint",
+	"network_connectivity",
+	"network_regenerate",
+	"network_created_at",
+	"network_events",
+	"network_ovn_uplink_capacity",
+	"network_scheduled_changes",
+	"network_state_all_members",
+	"network_project_default",
+	"network_update_force",
+	"network_allowed_projects",
+	"network_ovn_router_port",
+	"network_consistency",
+	"network_bulk_delete",
+	"network_startup_priority",
+	"network_restart_keys",
+	"network_leases_instance",
+	"network_render",
+	"network_create_result",
+	"network_create_lint_member_config",
+	"network_check_subnet",
+	"network_state_queues",
+	"network_config_annotations",
+	"network_apply",
+	"network_ovn_uplink_address_validation",
+	"network_port_bindings",
+	"network_ipv6_ra",
+	"network_group_by_uplink",
+	"network_recreate",
+	"network_state_ports",
+	"network_names_case_insensitive",
+	"network_state_firewall",
+	"network_leases_import",
+	"network_uses_acl_filter",
+	"network_bridge_gateway_validation",
+	"network_reconcile",
+	"network_state_lifetime_counters",
+	"network_pagination",
+	"network_create_validate",
+	"network_types",
+	"network_state_all_members_errors",
+	"network_lease_delete",
+	"network_lease_reservations",
+	"network_leases_filter",
+	"network_leases_slaac",
+	"network_stats",
+	"network_create_retries",
+	"network_create_async",
+	"network_update_preview",
+	"network_type_defaults",
+	"network_used_by_references",
+	"network_delete_force",
+	"network_copy",
+	"network_export",
+	"network_import",
+	"network_errored_event",
+	"network_startup_retry",
+	"network_restart",
+	"network_restart_operation",
+	"network_state_etag",
+	"network_state_members",
+	"network_state_ovn_topology",
+	"network_filter_used",
+	"networks_all_projects_filter",
+	"networks_all_projects_urls",
+	"network_apply_existing_type",
+	"network_restricted_forbidden",
+	"network_state_effective_mtu",
+	"network_bulk_delete_names",
+	"network_validate_keys",
+	"network_location_status",
+	"network_member_timeout",
+	"network_description_update",
+	"network_state_qos",
+	"network_instances",
+	"network_address_families",
+	"project_network_default_type",
+	"network_uuid",
+	"network_state_dhcp_pools",
+	"networks_sort",
+	"network_subnet_overlap_check",
+	"network_state_unmanaged_members",
+	"network_delete_check",
+	"network_config_variables",
+	"network_ovn_uplink_change",
+	"network_events_stream",
 }
 
 // APIExtensionsCount returns the number of available API extensions.
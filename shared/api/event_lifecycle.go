@@ -76,6 +76,7 @@ const (
 	EventLifecycleNetworkAddressSetUpdated          = "network-address-set-updated"
 	EventLifecycleNetworkCreated                    = "network-created"
 	EventLifecycleNetworkDeleted                    = "network-deleted"
+	EventLifecycleNetworkErrored                    = "network-errored"
 	EventLifecycleNetworkForwardCreated             = "network-forward-created"
 	EventLifecycleNetworkForwardDeleted             = "network-forward-deleted"
 	EventLifecycleNetworkForwardUpdated             = "network-forward-updated"
@@ -1,5 +1,9 @@
 package api
 
+import (
+	"time"
+)
+
 // NetworksPost represents the fields of a new network
 //
 // swagger:model
@@ -15,6 +19,12 @@ type NetworksPost struct {
 	// The network type (refer to doc/networks.md)
 	// Example: bridge
 	Type string `json:"type" yaml:"type"`
+
+	// Name of an existing network in the same project to copy the configuration from
+	// Example: mybr0
+	//
+	// API extension: network_copy
+	Source string `json:"source,omitempty" yaml:"source,omitempty"`
 }
 
 // NetworkPost represents the fields required to rename a network
@@ -81,6 +91,13 @@ type Network struct {
 	// Example: ["/1.0/profiles/default", "/1.0/instances/c1"]
 	UsedBy []string `json:"used_by" yaml:"used_by"`
 
+	// List of URLs of the ACLs attached to the network and of its forwards and load balancers
+	// Read only: true
+	// Example: ["/1.0/network-acls/web", "/1.0/networks/ovn0/forwards/192.0.2.1"]
+	//
+	// API extension: network_used_by_references
+	ReferencedBy []string `json:"referenced_by,omitempty" yaml:"referenced_by,omitempty"`
+
 	// Whether this is a managed network
 	// Read only: true
 	// Example: true
@@ -102,11 +119,80 @@ type Network struct {
 	// API extension: clustering
 	Locations []string `json:"locations" yaml:"locations"`
 
+	// State of the network on each cluster member (only filled when not targeting a member)
+	// Read only: true
+	// Example: {"server01": "Created", "server02": "Pending"}
+	//
+	// API extension: network_location_status
+	LocationStatus map[string]string `json:"location_status,omitempty" yaml:"location_status,omitempty"`
+
+	// IP address families configured on the network (ipv4 and/or ipv6, managed networks only)
+	// Read only: true
+	// Example: ["ipv4", "ipv6"]
+	//
+	// API extension: network_address_families
+	AddressFamilies []string `json:"address_families,omitempty" yaml:"address_families,omitempty"`
+
 	// Project name
 	// Example: project1
 	//
 	// API extension: networks_all_projects
 	Project string `json:"project" yaml:"project"`
+
+	// Network creation timestamp
+	// Read only: true
+	// Example: 2025-02-18T12:50:32.204Z
+	//
+	// API extension: network_created_at
+	CreatedAt time.Time `json:"created_at" yaml:"created_at"`
+
+	// Stable identifier of the network, kept across renames (managed networks only)
+	// Read only: true
+	// Example: 4ab8e2a6-47cc-4a5b-8c1c-3a52e1a0b1f4
+	//
+	// API extension: network_uuid
+	UUID string `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+
+	// Pending scheduled config change (if any)
+	// Read only: true
+	//
+	// API extension: network_scheduled_changes
+	ScheduledChange *NetworkScheduledChange `json:"scheduled_change" yaml:"scheduled_change"`
+
+	// Whether this is the default network of the project (network.default)
+	// Read only: true
+	// Example: true
+	//
+	// API extension: network_project_default
+	ProjectDefault bool `json:"project_default" yaml:"project_default"`
+
+	// Projects allowed to use this network
+	// Read only: true
+	// Example: ["default", "project1"]
+	//
+	// API extension: network_allowed_projects
+	AllowedProjects []string `json:"allowed_projects" yaml:"allowed_projects"`
+
+	// Config keys whose change restarts the network (changes to other keys are applied live)
+	// Read only: true
+	// Example: ["bridge.driver"]
+	//
+	// API extension: network_restart_keys
+	RestartKeys []string `json:"restart_keys" yaml:"restart_keys"`
+
+	// Driver artifacts rendered from the current config (only with render=true)
+	// Read only: true
+	// Example: {"dnsmasq.args": "--keep-in-foreground\n--strict-order\n"}
+	//
+	// API extension: network_render
+	Rendered map[string]string `json:"rendered,omitempty" yaml:"rendered,omitempty"`
+
+	// Comments attached to config keys (from the user.comment.KEY config keys)
+	// Read only: true
+	// Example: {"ipv4.address": "Matches the upstream router allocation"}
+	//
+	// API extension: network_config_annotations
+	Annotations map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
 }
 
 // Writable converts a full Network struct into a NetworkPut struct (filters read-only fields).
@@ -114,6 +200,68 @@ func (network *Network) Writable() NetworkPut {
 	return network.NetworkPut
 }
 
+// NetworkScheduledChange represents a network config change waiting to be applied
+//
+// swagger:model
+//
+// API extension: network_scheduled_changes.
+type NetworkScheduledChange struct {
+	NetworkPut `yaml:",inline"`
+
+	// When the change will be applied
+	// Example: 2025-02-18T22:00:00Z
+	ApplyAt time.Time `json:"apply_at" yaml:"apply_at"`
+
+	// Whether the change replaces (PUT) or is merged into (PATCH) the network config
+	// Example: PUT
+	Method string `json:"method" yaml:"method"`
+}
+
+// NetworkLeasesImport represents the leases to import into a network
+//
+// swagger:model
+//
+// API extension: network_leases_import.
+type NetworkLeasesImport struct {
+	// Content of a dnsmasq leases file
+	// Example: 1735689600 10:66:6a:5a:83:57 10.109.89.50 printer 01:10:66:6a:5a:83:57
+	Leases string `json:"leases" yaml:"leases"`
+}
+
+// NetworkLeaseReservation represents a static DHCP reservation to add to a network
+//
+// swagger:model
+//
+// API extension: network_lease_reservations.
+type NetworkLeaseReservation struct {
+	// MAC address the reservation applies to
+	// Example: 10:66:6a:5a:83:57
+	Hwaddr string `json:"hwaddr" yaml:"hwaddr"`
+
+	// Hostname to hand out (optional)
+	// Example: printer
+	Hostname string `json:"hostname" yaml:"hostname"`
+
+	// Reserved IPv4 address (optional)
+	// Example: 10.109.89.50
+	IPv4Address string `json:"ipv4_address" yaml:"ipv4_address"`
+
+	// Reserved IPv6 address (optional)
+	// Example: fd42:4242:4242:1010::50
+	IPv6Address string `json:"ipv6_address" yaml:"ipv6_address"`
+}
+
+// NetworkLeasesImportResult represents the outcome of a leases import
+//
+// swagger:model
+//
+// API extension: network_leases_import.
+type NetworkLeasesImportResult struct {
+	// Number of imported leases
+	// Example: 12
+	Imported int `json:"imported" yaml:"imported"`
+}
+
 // NetworkLease represents a DHCP lease
 //
 // swagger:model
@@ -132,7 +280,7 @@ type NetworkLease struct {
 	// Example: 10.0.0.98
 	Address string `json:"address" yaml:"address"`
 
-	// The type of record (static or dynamic)
+	// The type of record (static, dynamic, reservation, slaac, gateway or uplink)
 	// Example: dynamic
 	Type string `json:"type" yaml:"type"`
 
@@ -141,6 +289,18 @@ type NetworkLease struct {
 	//
 	// API extension: network_leases_location
 	Location string `json:"location" yaml:"location"`
+
+	// Name of the instance holding the lease (if known)
+	// Example: c1
+	//
+	// API extension: network_leases_instance
+	Instance string `json:"instance,omitempty" yaml:"instance,omitempty"`
+
+	// Project of the instance holding the lease (if known)
+	// Example: default
+	//
+	// API extension: network_leases_instance
+	Project string `json:"project,omitempty" yaml:"project,omitempty"`
 }
 
 // NetworkState represents the network state
@@ -188,6 +348,177 @@ type NetworkState struct {
 	//
 	// API extension: network_state_ovn
 	OVN *NetworkStateOVN `json:"ovn" yaml:"ovn"`
+
+	// Remaining capacity in the OVN ranges (for networks used as OVN uplinks)
+	//
+	// API extension: network_ovn_uplink_capacity
+	OVNUplinkCapacity *NetworkStateOVNUplinkCapacity `json:"ovn_uplink_capacity" yaml:"ovn_uplink_capacity"`
+
+	// Per-queue information (only filled when requested with detail=queues)
+	//
+	// API extension: network_state_queues
+	Queues []NetworkStateQueue `json:"queues,omitempty" yaml:"queues,omitempty"`
+
+	// Whether IPv6 router advertisements are sent (only for managed networks with IPv6)
+	// Example: true
+	//
+	// API extension: network_ipv6_ra
+	IPv6RouterAdvertisements *bool `json:"ipv6_router_advertisements,omitempty" yaml:"ipv6_router_advertisements,omitempty"`
+
+	// Instance ports of the network (only filled when requested with detail=ports)
+	//
+	// API extension: network_state_ports
+	Ports []NetworkPortBinding `json:"ports,omitempty" yaml:"ports,omitempty"`
+
+	// Traffic limits applied to the ports of the network (only filled when requested with detail=qos)
+	//
+	// API extension: network_state_qos
+	QoS []NetworkStateQoSPort `json:"qos,omitempty" yaml:"qos,omitempty"`
+
+	// Utilization of the DHCP address pools (managed networks only, with detail=dhcp)
+	//
+	// API extension: network_state_dhcp_pools
+	DHCPPools []NetworkStateDHCPPool `json:"dhcp_pools,omitempty" yaml:"dhcp_pools,omitempty"`
+
+	// Effective firewall and NAT setup (bridge networks only)
+	//
+	// API extension: network_state_firewall
+	Firewall *NetworkStateFirewall `json:"firewall,omitempty" yaml:"firewall,omitempty"`
+
+	// Interface counters accumulated since the network was created, across interface recreations (bridge networks only)
+	//
+	// API extension: network_state_lifetime_counters
+	LifetimeCounters *NetworkStateCounters `json:"lifetime_counters,omitempty" yaml:"lifetime_counters,omitempty"`
+
+	// MTU the network is set up with and where it comes from (bridge networks only)
+	//
+	// API extension: network_state_effective_mtu
+	EffectiveMTU *NetworkStateEffectiveMTU `json:"effective_mtu,omitempty" yaml:"effective_mtu,omitempty"`
+
+	// Error getting the state from the cluster member (only set when requested with all-members)
+	// Example: Failed getting network state: Cluster member is offline
+	//
+	// API extension: network_state_all_members_errors
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// NetworkStateDHCPPool represents the utilization of a DHCP address pool
+//
+// swagger:model
+//
+// API extension: network_state_dhcp_pools.
+type NetworkStateDHCPPool struct {
+	// Address family of the pool (inet or inet6)
+	// Example: inet
+	Family string `json:"family" yaml:"family"`
+
+	// Number of addresses allocated from the pool
+	// Example: 240
+	Allocated uint64 `json:"allocated" yaml:"allocated"`
+
+	// Total number of addresses in the pool
+	// Example: 253
+	Total uint64 `json:"total" yaml:"total"`
+}
+
+// NetworkStateQoSPort represents the traffic limits applied to a port of a network
+//
+// swagger:model
+//
+// API extension: network_state_qos.
+type NetworkStateQoSPort struct {
+	// Name of the port (host interface or OVN logical switch port)
+	// Example: veth1a2b3c4d
+	Name string `json:"name" yaml:"name"`
+
+	// Limit in bits per second of the traffic sent to the device attached to the port (0 when unlimited)
+	// Example: 100000000
+	Ingress uint64 `json:"ingress" yaml:"ingress"`
+
+	// Limit in bits per second of the traffic received from the device attached to the port (0 when unlimited)
+	// Example: 50000000
+	Egress uint64 `json:"egress" yaml:"egress"`
+}
+
+// NetworkStateEffectiveMTU represents the MTU a network is set up with
+//
+// swagger:model
+//
+// API extension: network_state_effective_mtu.
+type NetworkStateEffectiveMTU struct {
+	// MTU the network is set up with
+	// Example: 1400
+	MTU uint32 `json:"mtu" yaml:"mtu"`
+
+	// Where the MTU comes from (config, tunnels or default)
+	// Example: tunnels
+	Source string `json:"source" yaml:"source"`
+}
+
+// NetworkStateFirewall represents the effective firewall and NAT setup of a network
+//
+// swagger:model
+//
+// API extension: network_state_firewall.
+type NetworkStateFirewall struct {
+	// Firewall driver in use on the server
+	// Example: nftables
+	Driver string `json:"driver" yaml:"driver"`
+
+	// IPv4 forwarding mode (nat, routed or none when IPv4 isn't configured)
+	// Example: nat
+	IPv4Mode string `json:"ipv4_mode" yaml:"ipv4_mode"`
+
+	// IPv6 forwarding mode (nat, routed or none when IPv6 isn't configured)
+	// Example: routed
+	IPv6Mode string `json:"ipv6_mode" yaml:"ipv6_mode"`
+
+	// Whether firewall rules are applied for IPv4
+	// Example: true
+	IPv4Firewall bool `json:"ipv4_firewall" yaml:"ipv4_firewall"`
+
+	// Whether firewall rules are applied for IPv6
+	// Example: true
+	IPv6Firewall bool `json:"ipv6_firewall" yaml:"ipv6_firewall"`
+}
+
+// NetworkStateQueue represents the state of a single interface queue
+//
+// swagger:model
+//
+// API extension: network_state_queues.
+type NetworkStateQueue struct {
+	// Queue name
+	// Example: tx-0
+	Name string `json:"name" yaml:"name"`
+
+	// Queue direction (rx or tx)
+	// Example: tx
+	Direction string `json:"direction" yaml:"direction"`
+
+	// Queue index
+	// Example: 0
+	Index uint64 `json:"index" yaml:"index"`
+
+	// CPU mask steering traffic for the queue (RPS for rx, XPS for tx)
+	// Example: 0f
+	CPUs string `json:"cpus" yaml:"cpus"`
+
+	// Number of RPS flow entries (rx only)
+	// Example: 0
+	Flows uint64 `json:"flows" yaml:"flows"`
+
+	// Maximum transmit rate in Mbps, 0 meaning unlimited (tx only)
+	// Example: 0
+	MaxRate uint64 `json:"max_rate" yaml:"max_rate"`
+
+	// Bytes currently queued to the device (tx only)
+	// Example: 4096
+	BytesInflight uint64 `json:"bytes_inflight" yaml:"bytes_inflight"`
+
+	// Current byte queue limit (tx only)
+	// Example: 65536
+	BytesLimit uint64 `json:"bytes_limit" yaml:"bytes_limit"`
 }
 
 // NetworkStateAddress represents a network address
@@ -265,6 +596,11 @@ type NetworkStateBond struct {
 	// List of devices that are part of the bond
 	// Example: ["eth0", "eth1"]
 	LowerDevices []string `json:"lower_devices" yaml:"lower_devices"`
+
+	// State of the devices that are part of the bond
+	//
+	// API extension: network_state_members
+	Members []NetworkStateMember `json:"members" yaml:"members"`
 }
 
 // NetworkStateBridge represents bridge specific state
@@ -296,6 +632,37 @@ type NetworkStateBridge struct {
 	// List of devices that are in the bridge
 	// Example: ["eth0", "eth1"]
 	UpperDevices []string `json:"upper_devices" yaml:"upper_devices"`
+
+	// State of the devices that are in the bridge
+	//
+	// API extension: network_state_members
+	Members []NetworkStateMember `json:"members" yaml:"members"`
+}
+
+// NetworkStateMember represents the state of a device that is part of a bond or bridge
+//
+// swagger:model
+//
+// API extension: network_state_members.
+type NetworkStateMember struct {
+	// Device name
+	// Example: eth0
+	Name string `json:"name" yaml:"name"`
+
+	// Link state
+	// Example: up
+	State string `json:"state" yaml:"state"`
+
+	// Link speed in Mbit/s (0 if unknown)
+	// Example: 10000
+	Speed uint64 `json:"speed" yaml:"speed"`
+
+	// Bond member link state (only for bond members)
+	// Example: up
+	MIIState string `json:"mii_state,omitempty" yaml:"mii_state,omitempty"`
+
+	// Device counters
+	Counters *NetworkStateCounters `json:"counters" yaml:"counters"`
 }
 
 // NetworkStateVLAN represents VLAN specific state
@@ -346,4 +713,524 @@ type NetworkStateOVN struct {
 	//
 	// API extension: network_ovn_state_addresses
 	UplinkIPv6 string `json:"uplink_ipv6" yaml:"uplink_ipv6"`
+
+	// OVN logical topology (only visible to users who can edit the network)
+	//
+	// API extension: network_state_ovn_topology
+	Topology *NetworkStateOVNTopology `json:"topology,omitempty" yaml:"topology,omitempty"`
+}
+
+// NetworkStateOVNTopology represents the OVN logical objects making up the network
+//
+// swagger:model
+//
+// API extension: network_state_ovn_topology.
+type NetworkStateOVNTopology struct {
+	// Ports of the logical switch
+	LogicalSwitchPorts []NetworkStateOVNLogicalSwitchPort `json:"logical_switch_ports" yaml:"logical_switch_ports"`
+
+	// Ports of the logical router
+	LogicalRouterPorts []NetworkStateOVNLogicalRouterPort `json:"logical_router_ports" yaml:"logical_router_ports"`
+
+	// Binding of the network to its uplink (nil if the network has no uplink)
+	UplinkBinding *NetworkStateOVNUplinkBinding `json:"uplink_binding" yaml:"uplink_binding"`
+}
+
+// NetworkStateOVNLogicalSwitchPort represents a port of the OVN logical switch
+//
+// swagger:model
+//
+// API extension: network_state_ovn_topology.
+type NetworkStateOVNLogicalSwitchPort struct {
+	// Port name
+	// Example: incus-net1-instance-4d4ed5b1-2a6e-4b1c-8e2b-4ab0e8b3a9d2-eth0
+	Name string `json:"name" yaml:"name"`
+
+	// Port type (empty for instance ports)
+	// Example: router
+	Type string `json:"type" yaml:"type"`
+
+	// Port addresses (static and dynamic)
+	// Example: ["00:16:3e:4f:52:1c 10.0.0.2"]
+	Addresses []string `json:"addresses" yaml:"addresses"`
+
+	// Whether the port is up
+	// Example: true
+	Up bool `json:"up" yaml:"up"`
+}
+
+// NetworkStateOVNLogicalRouterPort represents a port of the OVN logical router
+//
+// swagger:model
+//
+// API extension: network_state_ovn_topology.
+type NetworkStateOVNLogicalRouterPort struct {
+	// Port name
+	// Example: incus-net1-lr-lrp-int
+	Name string `json:"name" yaml:"name"`
+
+	// MAC address
+	// Example: 00:16:3e:4f:52:1c
+	MAC string `json:"mac" yaml:"mac"`
+
+	// Port networks
+	// Example: ["10.0.0.1/24"]
+	Networks []string `json:"networks" yaml:"networks"`
+}
+
+// NetworkStateOVNUplinkBinding represents the binding of an OVN network to its uplink network
+//
+// swagger:model
+//
+// API extension: network_state_ovn_topology.
+type NetworkStateOVNUplinkBinding struct {
+	// Uplink network name
+	// Example: UPLINK
+	Network string `json:"network" yaml:"network"`
+
+	// Logical router port connected to the uplink
+	// Example: incus-net1-lr-lrp-ext
+	Port string `json:"port" yaml:"port"`
+
+	// Chassis currently hosting the uplink port
+	// Example: server01
+	Chassis string `json:"chassis" yaml:"chassis"`
+}
+
+// NetworkStateOVNUplinkCapacity represents the usage of an uplink network's OVN ranges
+//
+// swagger:model
+//
+// API extension: network_ovn_uplink_capacity.
+type NetworkStateOVNUplinkCapacity struct {
+	// Number of free addresses in ipv4.ovn.ranges
+	// Example: 12
+	IPv4Free uint64 `json:"ipv4_free" yaml:"ipv4_free"`
+
+	// Total number of addresses in ipv4.ovn.ranges
+	// Example: 100
+	IPv4Total uint64 `json:"ipv4_total" yaml:"ipv4_total"`
+
+	// Number of free addresses in ipv6.ovn.ranges
+	// Example: 65520
+	IPv6Free uint64 `json:"ipv6_free" yaml:"ipv6_free"`
+
+	// Total number of addresses in ipv6.ovn.ranges
+	// Example: 65536
+	IPv6Total uint64 `json:"ipv6_total" yaml:"ipv6_total"`
+}
+
+// NetworkConnectivity represents the result of a gateway reachability test from a cluster member
+//
+// swagger:model
+//
+// API extension: network_connectivity.
+type NetworkConnectivity struct {
+	// Cluster member the test was run from
+	// Example: server01
+	Location string `json:"location" yaml:"location"`
+
+	// Result of probing each of the network's gateways
+	Gateways []NetworkConnectivityGateway `json:"gateways" yaml:"gateways"`
+
+	// Error encountered while running the test on the member (if any)
+	// Example: Failed connecting to member
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// NetworkConnectivityGateway represents the reachability of a single gateway
+//
+// swagger:model
+//
+// API extension: network_connectivity.
+type NetworkConnectivityGateway struct {
+	// Gateway address
+	// Example: 10.0.0.1
+	Address string `json:"address" yaml:"address"`
+
+	// Whether the gateway responded
+	// Example: true
+	Reachable bool `json:"reachable" yaml:"reachable"`
+}
+
+// NetworkConsistency represents the result of checking that a network's database record, authorizer entry and
+// dataplane state agree with each other
+//
+// swagger:model
+//
+// API extension: network_consistency.
+type NetworkConsistency struct {
+	// Whether all the checks passed
+	// Example: false
+	Consistent bool `json:"consistent" yaml:"consistent"`
+
+	// Result of checking the database record
+	Database NetworkConsistencyCheck `json:"database" yaml:"database"`
+
+	// Result of checking the authorizer entry
+	Authorizer NetworkConsistencyCheck `json:"authorizer" yaml:"authorizer"`
+
+	// Result of checking the dataplane on each cluster member
+	Dataplane []NetworkConsistencyCheck `json:"dataplane" yaml:"dataplane"`
+}
+
+// NetworkConsistencyCheck represents the result of a single network consistency check
+//
+// swagger:model
+//
+// API extension: network_consistency.
+type NetworkConsistencyCheck struct {
+	// Cluster member the check was run on (if member specific)
+	// Example: server01
+	Location string `json:"location,omitempty" yaml:"location,omitempty"`
+
+	// Whether the check passed
+	// Example: false
+	Consistent bool `json:"consistent" yaml:"consistent"`
+
+	// Description of the mismatch (if any)
+	// Example: Bridge interface "incusbr0" not found
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+// NetworksDeleteResult represents the outcome of deleting a network as part of a bulk deletion
+//
+// swagger:model
+//
+// API extension: network_bulk_delete.
+type NetworksDeleteResult struct {
+	// Name of the network
+	// Example: tenant1-net
+	Name string `json:"name" yaml:"name"`
+
+	// Project of the network
+	// Example: default
+	Project string `json:"project" yaml:"project"`
+
+	// Reason the network couldn't be deleted (empty on success)
+	// Example: The network is currently in use
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// NetworkDeleteCheck represents whether a network can be deleted and what is preventing it
+//
+// swagger:model
+//
+// API extension: network_delete_check.
+type NetworkDeleteCheck struct {
+	// Whether the network can be deleted
+	// Example: false
+	Deletable bool `json:"deletable" yaml:"deletable"`
+
+	// Resources using the network and preventing its deletion (only those visible to the requestor)
+	// Example: ["/1.0/instances/c1", "/1.0/profiles/default"]
+	UsedBy []string `json:"used_by" yaml:"used_by"`
+}
+
+// NetworkPortBinding represents the MAC and IP addresses bound to an instance port by the network
+//
+// swagger:model
+//
+// API extension: network_port_bindings.
+type NetworkPortBinding struct {
+	// Name of the port
+	// Example: incus-net3-instance-6f9e2a8c-eth0
+	Port string `json:"port" yaml:"port"`
+
+	// Name of the instance using the port
+	// Example: c1
+	Instance string `json:"instance" yaml:"instance"`
+
+	// Name of the instance device using the port
+	// Example: eth0
+	Device string `json:"device" yaml:"device"`
+
+	// MAC address bound to the port
+	// Example: 10:66:6a:5a:83:57
+	Hwaddr string `json:"hwaddr" yaml:"hwaddr"`
+
+	// IP addresses bound to the port
+	// Example: ["10.109.89.2", "fd42:e2b6:ea59:7cb6:1266:6aff:fe5a:8357"]
+	Addresses []string `json:"addresses" yaml:"addresses"`
+
+	// Port security entries restricting the traffic allowed from the port
+	// Example: ["10:66:6a:5a:83:57 10.109.89.2"]
+	PortSecurity []string `json:"port_security" yaml:"port_security"`
+
+	// Whether the bindings are enforced (port security is set)
+	// Example: true
+	Enforced bool `json:"enforced" yaml:"enforced"`
+
+	// Link status of the port (up or down)
+	// Example: up
+	//
+	// API extension: network_state_ports
+	Status string `json:"status" yaml:"status"`
+
+	// Cluster member the port is bound to
+	// Example: server01
+	//
+	// API extension: network_state_ports
+	Location string `json:"location" yaml:"location"`
+}
+
+// NetworkInstance represents an instance NIC connected to the network along with its addresses
+//
+// swagger:model
+//
+// API extension: network_instances.
+type NetworkInstance struct {
+	// Name of the instance
+	// Example: c1
+	Name string `json:"name" yaml:"name"`
+
+	// Project of the instance
+	// Example: default
+	Project string `json:"project" yaml:"project"`
+
+	// Cluster member the instance is located on
+	// Example: server01
+	Location string `json:"location" yaml:"location"`
+
+	// Name of the instance device connected to the network
+	// Example: eth0
+	Device string `json:"device" yaml:"device"`
+
+	// MAC address of the instance device
+	// Example: 10:66:6a:5a:83:57
+	Hwaddr string `json:"hwaddr" yaml:"hwaddr"`
+
+	// Addresses assigned to the instance device, either statically or through DHCP leases
+	// Example: ["10.109.89.2", "fd42:e2b6:ea59:7cb6:1266:6aff:fe5a:8357"]
+	Addresses []string `json:"addresses" yaml:"addresses"`
+}
+
+// NetworkStats represents the traffic statistics of a network on a cluster member
+//
+// swagger:model
+//
+// API extension: network_stats.
+type NetworkStats struct {
+	// Aggregated traffic counters of the network on the cluster member (across all projects)
+	Counters NetworkStateCounters `json:"counters" yaml:"counters"`
+
+	// Traffic counters of the instances of the project connected to the network on the cluster member
+	Instances []NetworkStatsInstance `json:"instances" yaml:"instances"`
+}
+
+// NetworkStatsInstance represents the traffic statistics of an instance device connected to a network
+//
+// swagger:model
+//
+// API extension: network_stats.
+type NetworkStatsInstance struct {
+	// Name of the instance
+	// Example: c1
+	Name string `json:"name" yaml:"name"`
+
+	// Project of the instance
+	// Example: default
+	Project string `json:"project" yaml:"project"`
+
+	// Name of the instance device
+	// Example: eth0
+	Device string `json:"device" yaml:"device"`
+
+	// Traffic counters of the device (from the instance's point of view)
+	Counters NetworkStateCounters `json:"counters" yaml:"counters"`
+}
+
+// NetworkUplinkGroup represents an uplink network and the OVN networks using it
+//
+// swagger:model
+//
+// API extension: network_group_by_uplink.
+type NetworkUplinkGroup struct {
+	// Name of the uplink network (empty for OVN networks without an uplink)
+	// Example: UPLINK
+	Name string `json:"name" yaml:"name"`
+
+	// The uplink network (nil if not part of the listing)
+	Network *Network `json:"network" yaml:"network"`
+
+	// OVN networks using the uplink
+	Overlays []Network `json:"overlays" yaml:"overlays"`
+}
+
+// NetworkApplyResult represents the outcome of applying a desired network state
+//
+// swagger:model
+//
+// API extension: network_apply.
+type NetworkApplyResult struct {
+	// Action taken to converge the network (created, updated or unchanged)
+	// Example: updated
+	Action string `json:"action" yaml:"action"`
+
+	// Config keys that were added, changed or removed ("description" for the description)
+	// Example: ["ipv4.nat", "dns.domain"]
+	Changed []string `json:"changed" yaml:"changed"`
+}
+
+// NetworkUpdatePreview represents the outcome of a network update without applying it
+//
+// swagger:model
+//
+// API extension: network_update_preview.
+type NetworkUpdatePreview struct {
+	// Config the network would have after the update
+	// Example: {"ipv4.address": "10.0.0.1/24", "ipv4.nat": "true"}
+	Config map[string]string `json:"config" yaml:"config"`
+
+	// Config keys that would be added, changed or removed
+	// Example: ["ipv4.nat", "bridge.driver"]
+	ChangedKeys []string `json:"changed_keys" yaml:"changed_keys"`
+
+	// Changed config keys that would restart the network
+	// Example: ["bridge.driver"]
+	RestartKeys []string `json:"restart_keys" yaml:"restart_keys"`
+}
+
+// NetworkConfigValidation represents the outcome of validating a proposed network config
+//
+// swagger:model
+//
+// API extension: network_validate_keys.
+type NetworkConfigValidation struct {
+	// Whether the config is valid
+	// Example: false
+	Valid bool `json:"valid" yaml:"valid"`
+
+	// Validation failures of the individual config keys
+	// Example: {"ipv4.address": "Invalid value for network \"incusbr0\" option \"ipv4.address\": Not an IP address \"10.0.0\""}
+	Errors map[string]string `json:"errors" yaml:"errors"`
+
+	// Validation failure which doesn't relate to a single config key
+	// Example: Invalid DHCP range
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// NetworkSubnetCheck represents whether a subnet is free to use for a new network
+//
+// swagger:model
+//
+// API extension: network_check_subnet.
+type NetworkSubnetCheck struct {
+	// Subnet that was checked
+	// Example: 10.0.5.0/24
+	Subnet string `json:"subnet" yaml:"subnet"`
+
+	// Whether the subnet doesn't overlap any existing network
+	// Example: false
+	Available bool `json:"available" yaml:"available"`
+
+	// Existing network addressing overlapping the subnet
+	Conflicts []NetworkSubnetConflict `json:"conflicts" yaml:"conflicts"`
+}
+
+// NetworkSubnetConflict represents an existing network subnet overlapping a checked subnet
+//
+// swagger:model
+//
+// API extension: network_check_subnet.
+type NetworkSubnetConflict struct {
+	// Project of the network
+	// Example: default
+	Project string `json:"project" yaml:"project"`
+
+	// Name of the network
+	// Example: incusbr0
+	Network string `json:"network" yaml:"network"`
+
+	// Config key holding the overlapping subnet
+	// Example: ipv4.address
+	Key string `json:"key" yaml:"key"`
+
+	// Overlapping subnet
+	// Example: 10.0.5.0/24
+	Subnet string `json:"subnet" yaml:"subnet"`
+}
+
+// NetworkType represents a supported network type
+//
+// swagger:model
+//
+// API extension: network_types.
+type NetworkType struct {
+	// Name of the network type
+	// Example: bridge
+	Name string `json:"name" yaml:"name"`
+
+	// Whether the type can be used in projects with their own networks
+	// Example: false
+	Projects bool `json:"projects" yaml:"projects"`
+
+	// Whether the type requires member specific config when clustered
+	// Example: true
+	NodeSpecificConfig bool `json:"node_specific_config" yaml:"node_specific_config"`
+
+	// Whether the type supports network forwards
+	// Example: true
+	AddressForwards bool `json:"address_forwards" yaml:"address_forwards"`
+
+	// Whether the type supports network load balancers
+	// Example: false
+	LoadBalancers bool `json:"load_balancers" yaml:"load_balancers"`
+
+	// Whether the type supports network peering
+	// Example: false
+	Peering bool `json:"peering" yaml:"peering"`
+
+	// Known configuration keys
+	Config []NetworkTypeConfigKey `json:"config" yaml:"config"`
+}
+
+// NetworkTypeConfigKey represents a configuration key of a network type
+//
+// swagger:model
+//
+// API extension: network_types.
+type NetworkTypeConfigKey struct {
+	// Name of the key
+	// Example: ipv4.address
+	Key string `json:"key" yaml:"key"`
+
+	// Type of the value
+	// Example: string
+	Type string `json:"type" yaml:"type"`
+
+	// Description of the default value
+	// Example: `auto` (on create only)
+	Default string `json:"default" yaml:"default"`
+
+	// Short description of the key
+	// Example: IPv4 address for the bridge
+	Description string `json:"description" yaml:"description"`
+
+	// Condition for the key to apply
+	// Example: IPv4 address
+	Condition string `json:"condition" yaml:"condition"`
+
+	// Whether the key is cluster member specific
+	// Example: false
+	NodeSpecific bool `json:"node_specific" yaml:"node_specific"`
+}
+
+// NetworkExport represents a portable definition of a network and the resources it depends on
+//
+// swagger:model
+//
+// API extension: network_export.
+type NetworkExport struct {
+	// The network definition (member specific config removed)
+	Network NetworksPost `json:"network" yaml:"network"`
+
+	// Network ACLs referenced by the network
+	ACLs []NetworkACL `json:"acls" yaml:"acls"`
+
+	// Network forwards of the network
+	Forwards []NetworkForward `json:"forwards" yaml:"forwards"`
+
+	// Network zones referenced by the network
+	Zones []NetworkZone `json:"zones" yaml:"zones"`
 }